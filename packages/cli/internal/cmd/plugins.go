@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/madfam/enclii/packages/cli/internal/config"
+	"github.com/madfam/enclii/packages/cli/internal/health"
+)
+
+// pluginPrefix is the naming convention plugins must follow to be
+// discovered: an executable named "enclii-local-seed" on PATH becomes
+// `enclii local seed`, in the spirit of git's and juju's plugin systems.
+const pluginPrefix = "enclii-local-"
+
+// RcPassthroughError reports that a plugin subprocess exited non-zero. The
+// root command's main() should type-switch on this and os.Exit(Code)
+// directly rather than printing it like an ordinary error, mirroring how
+// juju's cmd package passes plugin exit codes through unchanged.
+type RcPassthroughError struct {
+	Code int
+}
+
+func (e *RcPassthroughError) Error() string {
+	return fmt.Sprintf("plugin exited with code %d", e.Code)
+}
+
+// PluginContext is everything NewLocalCommand already knows that a
+// third-party `enclii-local-<name>` plugin would otherwise have to
+// rediscover: resolved paths and a snapshot of which services are up. It's
+// marshaled to JSON once per invocation and handed to the plugin both on
+// stdin and in the ENCLII_LOCAL_CONTEXT environment variable, so plugins
+// can use whichever is more convenient for their language/runtime.
+type PluginContext struct {
+	LocalConfig       *LocalConfig `json:"local_config"`
+	SharedComposePath string       `json:"shared_compose_path"`
+	// Services maps a service name (as used by localStatus) to "running" or
+	// "stopped".
+	Services map[string]string `json:"services"`
+}
+
+// discoverPlugins returns one cobra.Command per enclii-local-<name>
+// executable found on PATH, ready to be added under `enclii local`.
+func discoverPlugins(cfg *config.Config) []*cobra.Command {
+	names := findPluginNames()
+	cmds := make([]*cobra.Command, 0, len(names))
+	for _, name := range names {
+		cmds = append(cmds, newPluginCommand(cfg, name))
+	}
+	return cmds
+}
+
+// findPluginNames scans PATH for executables named pluginPrefix+<name> and
+// returns the sorted, de-duplicated list of <name>s.
+func findPluginNames() []string {
+	seen := make(map[string]bool)
+	var names []string
+
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasPrefix(entry.Name(), pluginPrefix) {
+				continue
+			}
+			name := strings.TrimPrefix(entry.Name(), pluginPrefix)
+			if name == "" || seen[name] {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil || info.Mode()&0o111 == 0 {
+				continue
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func newPluginCommand(cfg *config.Config, name string) *cobra.Command {
+	binary := pluginPrefix + name
+	return &cobra.Command{
+		Use:                name,
+		Short:              pluginHelp(binary),
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPlugin(cfg, binary, args)
+		},
+	}
+}
+
+// pluginHelp scrapes a one-line summary from "<binary> --help"'s first
+// line of output, so `enclii local --help` can list plugins with a
+// description instead of just their name.
+func pluginHelp(binary string) string {
+	out, err := exec.Command(binary, "--help").Output()
+	if err != nil {
+		return fmt.Sprintf("Run the %s plugin", binary)
+	}
+	first, _, _ := strings.Cut(string(out), "\n")
+	return strings.TrimSpace(first)
+}
+
+// runPlugin execs binary with args, feeding it a PluginContext and
+// forwarding stdio, and translates a non-zero exit into a
+// *RcPassthroughError instead of cobra's usual "exit status N" message.
+func runPlugin(cfg *config.Config, binary string, args []string) error {
+	pctx, err := buildPluginContext()
+	if err != nil {
+		return fmt.Errorf("failed to build plugin context: %w", err)
+	}
+
+	payload, err := json.Marshal(pctx)
+	if err != nil {
+		return fmt.Errorf("failed to marshal plugin context: %w", err)
+	}
+
+	cmd := exec.Command(binary, args...)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), "ENCLII_LOCAL_CONTEXT="+string(payload))
+
+	if err := cmd.Run(); err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return &RcPassthroughError{Code: exitErr.ExitCode()}
+		}
+		return fmt.Errorf("failed to run plugin %s: %w", binary, err)
+	}
+
+	return nil
+}
+
+func buildPluginContext() (*PluginContext, error) {
+	localCfg, err := getLocalConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PluginContext{
+		LocalConfig:       localCfg,
+		SharedComposePath: localCfg.SharedComposePath,
+		Services:          currentServiceStatus(),
+	}, nil
+}
+
+// currentServiceStatus reports best-effort "running"/"stopped" state using
+// the exact same health.ServiceChecks LocalStatus runs for `enclii local
+// status`, so plugins can act conditionally (e.g. skip seeding a database
+// that isn't up yet) and a service added there automatically shows up here
+// too, instead of this re-deriving its own drifting list of docker/curl
+// shell-outs.
+func currentServiceStatus() map[string]string {
+	results := LocalStatus(context.Background())
+
+	status := make(map[string]string, len(results))
+	for _, r := range results {
+		if r.State == health.HealthHealthy {
+			status[r.Name] = "running"
+		} else {
+			status[r.Name] = "stopped"
+		}
+	}
+	return status
+}
@@ -0,0 +1,240 @@
+// Package health runs the readiness probes behind `enclii local up` and
+// `enclii local status` against a common set of CheckSpecs, instead of each
+// command hard-coding its own pg_isready/redis-cli/curl invocations.
+package health
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+)
+
+// CheckType selects how a CheckSpec is probed.
+type CheckType string
+
+const (
+	CheckTCP      CheckType = "tcp"
+	CheckHTTPGet  CheckType = "http_get"
+	CheckExec     CheckType = "exec"
+	CheckPostgres CheckType = "postgres"
+	CheckRedis    CheckType = "redis"
+	CheckMinIO    CheckType = "minio"
+	CheckSMTP     CheckType = "smtp"
+)
+
+// CheckSpec describes one probe. Which fields matter depends on Type:
+//
+//	tcp       Address
+//	http_get  URL
+//	exec      Command (run via "sh -c"; exit 0 is healthy)
+//	postgres  DSN (a postgres:// connection string)
+//	redis     Address, Password
+//	minio     URL
+//	smtp      URL
+type CheckSpec struct {
+	Type    CheckType
+	Address string
+	URL     string
+	Command string
+	DSN     string
+	// Container is kept only for generic Exec-based checks a caller
+	// wants to target at a container by name; postgres and redis probe
+	// the daemon directly and don't use it.
+	Container string
+	User      string
+	Password  string
+}
+
+// ServiceCheck names a CheckSpec and the policy for retrying it.
+type ServiceCheck struct {
+	Name        string
+	Spec        CheckSpec
+	Timeout     time.Duration // total time to wait before giving up. Default 60s.
+	Interval    time.Duration // delay between attempts. Default 1s.
+	MaxAttempts int           // 0 means unlimited (bounded only by Timeout).
+}
+
+// HealthState is the outcome of a ServiceCheck.
+type HealthState string
+
+const (
+	HealthHealthy   HealthState = "healthy"
+	HealthUnhealthy HealthState = "unhealthy"
+	HealthTimeout   HealthState = "timeout"
+)
+
+// ServiceHealth is one ServiceCheck's final result, after Runner has
+// retried it to success, exhaustion, or timeout.
+type ServiceHealth struct {
+	Name      string
+	State     HealthState
+	Latency   time.Duration
+	LastError error
+	Attempts  int
+}
+
+// RunOptions controls how RunChecks executes and reports on a batch of
+// ServiceChecks.
+type RunOptions struct {
+	// Concurrency bounds how many checks run at once. Default 4.
+	Concurrency int
+	// Stream, if set, receives a live-updating status table when it's a
+	// terminal, or one line per state change otherwise. Defaults to
+	// os.Stdout.
+	Stream io.Writer
+}
+
+// RunChecks probes every check concurrently (bounded by opts.Concurrency),
+// retrying each according to its own Interval/Timeout/MaxAttempts, and
+// returns one ServiceHealth per check once all of them have settled.
+func RunChecks(ctx context.Context, checks []ServiceCheck, opts RunOptions) []ServiceHealth {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+	stream := opts.Stream
+	if stream == nil {
+		stream = os.Stdout
+	}
+
+	results := make([]ServiceHealth, len(checks))
+	reporter := newReporter(stream, checks)
+	defer reporter.finish()
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, opts.Concurrency)
+
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check ServiceCheck) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			results[i] = runOne(ctx, check, reporter)
+		}(i, check)
+	}
+
+	wg.Wait()
+	return results
+}
+
+func runOne(ctx context.Context, check ServiceCheck, reporter *reporter) ServiceHealth {
+	timeout := check.Timeout
+	if timeout == 0 {
+		timeout = 60 * time.Second
+	}
+	interval := check.Interval
+	if interval == 0 {
+		interval = 1 * time.Second
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	var lastErr error
+	attempts := 0
+
+	for {
+		attempts++
+		attemptStart := time.Now()
+		lastErr = probe(checkCtx, check.Spec)
+		latency := time.Since(attemptStart)
+
+		if lastErr == nil {
+			result := ServiceHealth{Name: check.Name, State: HealthHealthy, Latency: latency, Attempts: attempts}
+			reporter.report(result)
+			return result
+		}
+
+		if check.MaxAttempts > 0 && attempts >= check.MaxAttempts {
+			result := ServiceHealth{Name: check.Name, State: HealthUnhealthy, Latency: time.Since(start), LastError: lastErr, Attempts: attempts}
+			reporter.report(result)
+			return result
+		}
+
+		select {
+		case <-checkCtx.Done():
+			result := ServiceHealth{Name: check.Name, State: HealthTimeout, Latency: time.Since(start), LastError: lastErr, Attempts: attempts}
+			reporter.report(result)
+			return result
+		case <-time.After(interval):
+		}
+	}
+}
+
+// probe runs spec's check exactly once.
+func probe(ctx context.Context, spec CheckSpec) error {
+	switch spec.Type {
+	case CheckTCP:
+		return probeTCP(ctx, spec.Address)
+	case CheckHTTPGet, CheckMinIO, CheckSMTP:
+		return probeHTTPGet(ctx, spec.URL)
+	case CheckExec:
+		return probeExec(ctx, spec.Command)
+	case CheckPostgres:
+		return probePostgres(ctx, spec.DSN)
+	case CheckRedis:
+		return probeRedis(ctx, spec.Address, spec.Password)
+	default:
+		return fmt.Errorf("unknown check type %q", spec.Type)
+	}
+}
+
+func probeTCP(ctx context.Context, address string) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func probeHTTPGet(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func probeExec(ctx context.Context, command string) error {
+	return exec.CommandContext(ctx, "sh", "-c", command).Run()
+}
+
+// probePostgres opens a short-lived connection pool against dsn and pings
+// it, rather than shelling out to `docker exec ... pg_isready`. This also
+// works against a Postgres that isn't running in a container at all.
+func probePostgres(ctx context.Context, dsn string) error {
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return fmt.Errorf("parse dsn: %w", err)
+	}
+	defer pool.Close()
+	return pool.Ping(ctx)
+}
+
+// probeRedis issues a PING over a direct client connection instead of
+// `docker exec ... redis-cli ping`.
+func probeRedis(ctx context.Context, addr, password string) error {
+	rdb := redis.NewClient(&redis.Options{Addr: addr, Password: password})
+	defer rdb.Close()
+	return rdb.Ping(ctx).Err()
+}
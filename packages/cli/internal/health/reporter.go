@@ -0,0 +1,105 @@
+package health
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// reporter renders ServiceHealth results to a stream as they arrive: a
+// live-updating table when the stream is a terminal (à la `docker compose
+// up`'s status pane), or one line per result otherwise (e.g. when output is
+// piped or redirected to a log file).
+type reporter struct {
+	mu       sync.Mutex
+	out      io.Writer
+	names    []string
+	live     bool
+	rendered int // number of lines the last live render printed, to erase before redrawing
+	latest   map[string]ServiceHealth
+}
+
+func newReporter(out io.Writer, checks []ServiceCheck) *reporter {
+	names := make([]string, len(checks))
+	for i, c := range checks {
+		names[i] = c.Name
+	}
+	return &reporter{
+		out:    out,
+		names:  names,
+		live:   isTerminal(out),
+		latest: make(map[string]ServiceHealth, len(checks)),
+	}
+}
+
+// report records a ServiceHealth result and redraws the table (or prints a
+// line, when not live).
+func (r *reporter) report(h ServiceHealth) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.latest[h.Name] = h
+
+	if !r.live {
+		fmt.Fprintf(r.out, "  %s %s (%s, attempt %d)\n", stateGlyph(h.State), h.Name, h.Latency.Round(roundTo), h.Attempts)
+		return
+	}
+
+	r.redraw()
+}
+
+func (r *reporter) finish() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.live {
+		r.redraw()
+	}
+}
+
+const roundTo = 1_000_000 // nanoseconds; i.e. round latency to the millisecond
+
+func (r *reporter) redraw() {
+	if r.rendered > 0 {
+		fmt.Fprintf(r.out, "\x1b[%dA", r.rendered) // move cursor up to the top of the table
+	}
+	for _, name := range r.names {
+		h, ok := r.latest[name]
+		if !ok {
+			fmt.Fprintf(r.out, "  %s %-20s waiting...\x1b[K\n", stateGlyph(""), name)
+			continue
+		}
+		line := fmt.Sprintf("  %s %-20s %s (attempt %d)", stateGlyph(h.State), name, h.Latency.Round(roundTo), h.Attempts)
+		if h.LastError != nil && h.State != HealthHealthy {
+			line += fmt.Sprintf(" — %v", h.LastError)
+		}
+		fmt.Fprintf(r.out, "%s\x1b[K\n", line)
+	}
+	r.rendered = len(r.names)
+}
+
+func stateGlyph(state HealthState) string {
+	switch state {
+	case HealthHealthy:
+		return "✓"
+	case HealthUnhealthy, HealthTimeout:
+		return "✗"
+	default:
+		return "…"
+	}
+}
+
+// isTerminal reports whether out is a character device, the same signal
+// golang.org/x/term.IsTerminal uses; implemented directly here so this
+// package doesn't need an extra dependency just for that one check.
+func isTerminal(out io.Writer) bool {
+	f, ok := out.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
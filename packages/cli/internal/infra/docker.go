@@ -0,0 +1,139 @@
+package infra
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	dockertypes "github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/nat"
+)
+
+// DockerDriver implements Driver against the local Docker daemon via the
+// Docker Engine SDK.
+type DockerDriver struct {
+	cli *client.Client
+}
+
+// NewDockerDriver connects to the Docker daemon using the same
+// DOCKER_HOST/DOCKER_CERT_PATH/DOCKER_TLS_VERIFY environment the docker CLI
+// honors, negotiating the API version so it works against a range of
+// daemon versions without pinning one here.
+func NewDockerDriver() (*DockerDriver, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("connect to docker daemon: %w", err)
+	}
+	return &DockerDriver{cli: cli}, nil
+}
+
+func (d *DockerDriver) EnsureRunning(ctx context.Context, specs []ContainerSpec) error {
+	for _, spec := range specs {
+		if err := d.ensureOne(ctx, spec); err != nil {
+			return fmt.Errorf("starting %s: %w", spec.Name, err)
+		}
+	}
+	return nil
+}
+
+func (d *DockerDriver) ensureOne(ctx context.Context, spec ContainerSpec) error {
+	existing, err := d.cli.ContainerInspect(ctx, spec.Name)
+	if err == nil {
+		if existing.State != nil && existing.State.Running {
+			return nil
+		}
+		return d.cli.ContainerStart(ctx, spec.Name, dockertypes.ContainerStartOptions{})
+	}
+	if !client.IsErrNotFound(err) {
+		return err
+	}
+
+	exposed, bindings, err := portConfig(spec.Ports)
+	if err != nil {
+		return err
+	}
+
+	env := make([]string, 0, len(spec.Env))
+	for k, v := range spec.Env {
+		env = append(env, fmt.Sprintf("%s=%s", k, v))
+	}
+
+	mounts := make([]string, 0, len(spec.Volumes))
+	for volume, path := range spec.Volumes {
+		mounts = append(mounts, fmt.Sprintf("%s:%s", volume, path))
+	}
+
+	created, err := d.cli.ContainerCreate(ctx,
+		&container.Config{
+			Image:        spec.Image,
+			Cmd:          spec.Command,
+			Env:          env,
+			ExposedPorts: exposed,
+		},
+		&container.HostConfig{
+			PortBindings: bindings,
+			Binds:        mounts,
+			RestartPolicy: container.RestartPolicy{
+				Name: "unless-stopped",
+			},
+		},
+		&network.NetworkingConfig{},
+		nil,
+		spec.Name,
+	)
+	if err != nil {
+		return fmt.Errorf("create container: %w", err)
+	}
+
+	return d.cli.ContainerStart(ctx, created.ID, dockertypes.ContainerStartOptions{})
+}
+
+func (d *DockerDriver) Stop(ctx context.Context, names []string) error {
+	for _, name := range names {
+		if err := d.cli.ContainerStop(ctx, name, container.StopOptions{}); err != nil {
+			if client.IsErrNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("stopping %s: %w", name, err)
+		}
+		if err := d.cli.ContainerRemove(ctx, name, dockertypes.ContainerRemoveOptions{}); err != nil && !client.IsErrNotFound(err) {
+			return fmt.Errorf("removing %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (d *DockerDriver) Logs(ctx context.Context, name string, opts LogOptions) (io.ReadCloser, error) {
+	tail := opts.Tail
+	if tail == "" {
+		tail = "all"
+	}
+	return d.cli.ContainerLogs(ctx, name, dockertypes.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     opts.Follow,
+		Since:      opts.Since,
+		Tail:       tail,
+	})
+}
+
+// portConfig converts ContainerSpec.Ports (container port -> host port)
+// into the nat.PortSet/PortMap pair ContainerCreate expects.
+func portConfig(ports map[string]string) (nat.PortSet, nat.PortMap, error) {
+	exposed := make(nat.PortSet, len(ports))
+	bindings := make(nat.PortMap, len(ports))
+
+	for containerPort, hostPort := range ports {
+		port, err := nat.NewPort("tcp", containerPort)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid port %q: %w", containerPort, err)
+		}
+		exposed[port] = struct{}{}
+		bindings[port] = []nat.PortBinding{{HostIP: "127.0.0.1", HostPort: hostPort}}
+	}
+
+	return exposed, bindings, nil
+}
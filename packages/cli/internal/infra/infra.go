@@ -0,0 +1,48 @@
+// Package infra starts and stops the shared local-development containers
+// (PostgreSQL, Redis, MinIO, MailHog) directly through the Docker Engine
+// API, instead of shelling out to the docker compose CLI. This removes a
+// hidden requirement on a particular docker CLI version being on PATH and
+// lets the CLI be tested against a fake Driver.
+package infra
+
+import (
+	"context"
+	"io"
+)
+
+// ContainerSpec describes one container Driver should ensure is running.
+// It's the SDK equivalent of one service entry in a docker-compose file.
+type ContainerSpec struct {
+	Name    string
+	Image   string
+	Command []string
+	Env     map[string]string
+	// Ports maps container port (e.g. "5432") to the host port it should
+	// be published on.
+	Ports map[string]string
+	// Volumes maps a named volume to its mount path inside the container.
+	Volumes map[string]string
+}
+
+// LogOptions controls Driver.Logs.
+type LogOptions struct {
+	Follow bool
+	Since  string // RFC3339 timestamp or Docker duration string, e.g. "10m"
+	Tail   string // number of lines, or "all"
+}
+
+// Driver starts, stops, and streams logs from the shared infrastructure
+// containers. It's implemented by DockerDriver; a future podman or nerdctl
+// backend only needs to implement this interface.
+type Driver interface {
+	// EnsureRunning creates and starts any container in specs that isn't
+	// already running, reusing an existing one with a matching name and
+	// image if present.
+	EnsureRunning(ctx context.Context, specs []ContainerSpec) error
+	// Stop stops and removes the named containers. Unknown names are
+	// ignored, so Stop is safe to call even if EnsureRunning never ran.
+	Stop(ctx context.Context, names []string) error
+	// Logs streams the named container's logs per opts. The caller must
+	// close the returned ReadCloser.
+	Logs(ctx context.Context, name string, opts LogOptions) (io.ReadCloser, error)
+}
@@ -0,0 +1,188 @@
+// Package supervisor loads a declarative service manifest and runs the
+// processes it describes, replacing the hard-coded startJanua/startEnclii
+// shell-orchestration that used to live in cmd.localUp.
+package supervisor
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// HealthCheck describes how to decide a service has finished starting.
+// Exactly one of URL or Command should be set; if neither is set the
+// service is considered healthy as soon as its process starts.
+type HealthCheck struct {
+	// URL is polled with a GET request; any 2xx response is healthy.
+	URL string `yaml:"url,omitempty"`
+	// Command is run via "sh -c"; a zero exit status is healthy.
+	Command string `yaml:"command,omitempty"`
+	// Interval between checks. Defaults to 1s.
+	Interval time.Duration `yaml:"interval,omitempty"`
+	// Timeout is the total time to wait before giving up. Defaults to 60s.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// ServiceSpec describes one process the supervisor can start.
+type ServiceSpec struct {
+	// Name identifies the service in the manifest, on the command line
+	// (`enclii local up <name>`), and in PID/log file names.
+	Name string `yaml:"name"`
+	// WorkDir is the process's working directory.
+	WorkDir string `yaml:"work_dir"`
+	// Command is the argv to exec: Command[0] is the binary, the rest are
+	// its arguments.
+	Command []string `yaml:"command"`
+	// Env lists additional environment variables, merged over the
+	// supervisor's own environment.
+	Env map[string]string `yaml:"env,omitempty"`
+	// Port is informational (surfaced in `enclii local status`); the
+	// supervisor doesn't enforce it.
+	Port int `yaml:"port,omitempty"`
+	// HealthCheck, if set, gates services that depend on this one: they
+	// won't start until this check passes.
+	HealthCheck *HealthCheck `yaml:"health_check,omitempty"`
+	// DependsOn lists service names that must be started (and healthy, if
+	// they define a HealthCheck) before this one starts.
+	DependsOn []string `yaml:"depends_on,omitempty"`
+	// LogFile is where stdout/stderr are written. Relative paths are
+	// resolved under the supervisor's state directory. Defaults to
+	// "<name>.log".
+	LogFile string `yaml:"log_file,omitempty"`
+}
+
+// ServiceRegistry is the parsed form of a service manifest, e.g.
+// ~/labspace/madfam.services.yaml.
+type ServiceRegistry struct {
+	Services []ServiceSpec `yaml:"services"`
+}
+
+// LoadServiceRegistry reads and validates the manifest at path.
+func LoadServiceRegistry(path string) (*ServiceRegistry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read service manifest %s: %w", path, err)
+	}
+
+	var reg ServiceRegistry
+	if err := yaml.Unmarshal(data, &reg); err != nil {
+		return nil, fmt.Errorf("parse service manifest %s: %w", path, err)
+	}
+
+	if err := reg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid service manifest %s: %w", path, err)
+	}
+
+	return &reg, nil
+}
+
+// Get returns the service named name, if present.
+func (r *ServiceRegistry) Get(name string) (ServiceSpec, bool) {
+	for _, svc := range r.Services {
+		if svc.Name == name {
+			return svc, true
+		}
+	}
+	return ServiceSpec{}, false
+}
+
+func (r *ServiceRegistry) validate() error {
+	seen := make(map[string]bool, len(r.Services))
+	for _, svc := range r.Services {
+		if svc.Name == "" {
+			return fmt.Errorf("service missing a name")
+		}
+		if seen[svc.Name] {
+			return fmt.Errorf("duplicate service name %q", svc.Name)
+		}
+		seen[svc.Name] = true
+		if len(svc.Command) == 0 {
+			return fmt.Errorf("service %q has an empty command", svc.Name)
+		}
+	}
+	for _, svc := range r.Services {
+		for _, dep := range svc.DependsOn {
+			if !seen[dep] {
+				return fmt.Errorf("service %q depends on undefined service %q", svc.Name, dep)
+			}
+		}
+	}
+	return nil
+}
+
+// StartOrder returns the subset of services named in only (or all services,
+// if only is empty) topologically sorted so each comes after everything it
+// depends on, including dependencies not named in only. It returns an error
+// if the dependency graph has a cycle.
+func (r *ServiceRegistry) StartOrder(only []string) ([]ServiceSpec, error) {
+	wanted := make(map[string]bool, len(r.Services))
+	if len(only) == 0 {
+		for _, svc := range r.Services {
+			wanted[svc.Name] = true
+		}
+	} else {
+		for _, name := range only {
+			if _, ok := r.Get(name); !ok {
+				return nil, fmt.Errorf("unknown service %q", name)
+			}
+			r.collectWithDeps(name, wanted)
+		}
+	}
+
+	var (
+		order    []ServiceSpec
+		visited  = make(map[string]bool, len(wanted))
+		visiting = make(map[string]bool, len(wanted))
+	)
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		if visited[name] {
+			return nil
+		}
+		if visiting[name] {
+			return fmt.Errorf("circular dependency involving %q", name)
+		}
+		visiting[name] = true
+
+		svc, _ := r.Get(name)
+		for _, dep := range svc.DependsOn {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+
+		visiting[name] = false
+		visited[name] = true
+		order = append(order, svc)
+		return nil
+	}
+
+	for _, svc := range r.Services {
+		if !wanted[svc.Name] {
+			continue
+		}
+		if err := visit(svc.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+// collectWithDeps adds name and everything it transitively depends on to wanted.
+func (r *ServiceRegistry) collectWithDeps(name string, wanted map[string]bool) {
+	if wanted[name] {
+		return
+	}
+	wanted[name] = true
+	svc, ok := r.Get(name)
+	if !ok {
+		return
+	}
+	for _, dep := range svc.DependsOn {
+		r.collectWithDeps(dep, wanted)
+	}
+}
@@ -0,0 +1,364 @@
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	restartBaseDelay = 1 * time.Second
+	restartMaxDelay  = 30 * time.Second
+	shutdownGrace    = 10 * time.Second
+)
+
+// Supervisor starts the services in a ServiceRegistry, tracks them via PID
+// files under stateDir, and (when run via Watch) restarts crashed processes
+// with exponential backoff.
+type Supervisor struct {
+	registry *ServiceRegistry
+	stateDir string
+
+	mu       sync.Mutex
+	procs    map[string]*managedProcess
+	stopping bool
+}
+
+type managedProcess struct {
+	spec     ServiceSpec
+	cmd      *exec.Cmd
+	log      *os.File
+	restarts int
+}
+
+// New returns a Supervisor that tracks PID and log files under stateDir
+// (created if it doesn't exist).
+func New(registry *ServiceRegistry, stateDir string) (*Supervisor, error) {
+	if err := os.MkdirAll(stateDir, 0o755); err != nil {
+		return nil, fmt.Errorf("create supervisor state dir %s: %w", stateDir, err)
+	}
+	return &Supervisor{
+		registry: registry,
+		stateDir: stateDir,
+		procs:    make(map[string]*managedProcess),
+	}, nil
+}
+
+// Up starts the named services (or all services, if names is empty) in
+// dependency order, waiting for each one's HealthCheck before starting
+// whatever depends on it.
+func (s *Supervisor) Up(ctx context.Context, names []string) error {
+	order, err := s.registry.StartOrder(names)
+	if err != nil {
+		return err
+	}
+
+	for _, spec := range order {
+		if err := s.startOne(spec); err != nil {
+			return fmt.Errorf("start %s: %w", spec.Name, err)
+		}
+		if spec.HealthCheck != nil {
+			if err := waitHealthy(ctx, spec); err != nil {
+				return fmt.Errorf("%s failed health check: %w", spec.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Watch blocks, restarting any tracked process that exits unexpectedly with
+// full-jitter exponential backoff, until ctx is cancelled. Callers
+// typically cancel ctx on SIGINT/SIGTERM and then call Down.
+func (s *Supervisor) Watch(ctx context.Context) {
+	<-ctx.Done()
+
+	s.mu.Lock()
+	s.stopping = true
+	s.mu.Unlock()
+}
+
+// startOne launches spec's process, writes its PID file, and arranges for
+// it to be restarted with backoff if it exits while the supervisor isn't
+// stopping. It also clears any stop marker left over from a previous Down
+// call targeting this service, so a fresh Up isn't mistaken for a process
+// that still needs to exit before the next restart decision.
+func (s *Supervisor) startOne(spec ServiceSpec) error {
+	os.Remove(s.stopMarkerPath(spec.Name))
+
+	cmd, logFile, err := s.buildCmd(spec)
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		logFile.Close()
+		return err
+	}
+
+	proc := &managedProcess{spec: spec, cmd: cmd, log: logFile}
+
+	s.mu.Lock()
+	s.procs[spec.Name] = proc
+	s.mu.Unlock()
+
+	if err := s.writePIDFile(spec.Name, cmd.Process.Pid); err != nil {
+		return err
+	}
+
+	go s.superviseOne(proc)
+
+	return nil
+}
+
+// superviseOne waits for proc's process to exit and, unless the
+// supervisor is stopping, restarts it with exponential backoff. "Stopping"
+// is checked two ways: the in-memory flag Watch sets (for a Down called
+// from this same process) and a stop marker file on disk (for a Down
+// called from a separate `enclii local down` invocation, which has no way
+// to reach into this process's memory) - without the latter, an
+// out-of-process Down would have its SIGTERM/SIGKILL misread as a crash
+// and immediately restarted, defeating the stop.
+func (s *Supervisor) superviseOne(proc *managedProcess) {
+	for {
+		err := proc.cmd.Wait()
+		proc.log.Close()
+
+		s.mu.Lock()
+		stopping := s.stopping
+		s.mu.Unlock()
+		if stopping || s.consumeStopMarker(proc.spec.Name) {
+			return
+		}
+
+		proc.restarts++
+		delay := restartBackoff(proc.restarts)
+		fmt.Printf("⚠ %s exited (%v); restarting in %s (attempt %d)\n", proc.spec.Name, err, delay, proc.restarts)
+		time.Sleep(delay)
+
+		cmd, logFile, buildErr := s.buildCmd(proc.spec)
+		if buildErr != nil {
+			fmt.Printf("✗ %s: failed to rebuild command: %v\n", proc.spec.Name, buildErr)
+			return
+		}
+		if startErr := cmd.Start(); startErr != nil {
+			logFile.Close()
+			fmt.Printf("✗ %s: restart failed: %v\n", proc.spec.Name, startErr)
+			return
+		}
+
+		proc.cmd = cmd
+		proc.log = logFile
+		_ = s.writePIDFile(proc.spec.Name, cmd.Process.Pid)
+	}
+}
+
+// restartBackoff computes a full-jitter exponential backoff delay for the
+// given restart attempt (1-indexed): sleep = rand(0, min(max, base*2^n)).
+func restartBackoff(attempt int) time.Duration {
+	capped := math.Min(float64(restartMaxDelay), float64(restartBaseDelay)*math.Pow(2, float64(attempt)))
+	return time.Duration(rand.Float64() * capped) //nolint:gosec // G404: backoff jitter doesn't need cryptographic randomness
+}
+
+func (s *Supervisor) buildCmd(spec ServiceSpec) (*exec.Cmd, *os.File, error) {
+	cmd := exec.Command(spec.Command[0], spec.Command[1:]...)
+	cmd.Dir = spec.WorkDir
+
+	env := os.Environ()
+	for k, v := range spec.Env {
+		env = append(env, k+"="+v)
+	}
+	cmd.Env = env
+
+	logPath := s.logPath(spec)
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open log file %s: %w", logPath, err)
+	}
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+
+	return cmd, logFile, nil
+}
+
+func (s *Supervisor) logPath(spec ServiceSpec) string {
+	name := spec.LogFile
+	if name == "" {
+		name = spec.Name + ".log"
+	}
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(s.stateDir, name)
+}
+
+func (s *Supervisor) pidPath(name string) string {
+	return filepath.Join(s.stateDir, name+".pid")
+}
+
+func (s *Supervisor) writePIDFile(name string, pid int) error {
+	return os.WriteFile(s.pidPath(name), []byte(strconv.Itoa(pid)), 0o644)
+}
+
+// stopMarkerPath is where Down records that name was stopped
+// intentionally, for superviseOne (possibly in another process) to find.
+func (s *Supervisor) stopMarkerPath(name string) string {
+	return stopMarkerPath(s.stateDir, name)
+}
+
+// consumeStopMarker reports whether a Down call left a stop marker for
+// name, removing it if so. superviseOne treats a marker exactly like the
+// in-memory stopping flag: an expected exit, not a crash to restart from.
+func (s *Supervisor) consumeStopMarker(name string) bool {
+	path := s.stopMarkerPath(name)
+	if _, err := os.Stat(path); err != nil {
+		return false
+	}
+	os.Remove(path)
+	return true
+}
+
+// stopMarkerPath is a package-level helper so Down - which only has a
+// stateDir, not a *Supervisor, since it may run in a separate process from
+// the one that called Up - can compute the same path as the Supervisor
+// method above.
+func stopMarkerPath(stateDir, name string) string {
+	return filepath.Join(stateDir, name+".stopping")
+}
+
+// Down reads every *.pid file under stateDir (so it works even when called
+// from a separate `enclii local down` invocation than the one that started
+// the services) and stops each process: SIGTERM, then SIGKILL if it hasn't
+// exited within shutdownGrace. Before signaling, it also drops a stop
+// marker file for each service, so the original `up` process's
+// superviseOne goroutine - which has no other way to learn about a Down
+// invoked from outside its own process - recognizes the exit as
+// intentional instead of restarting the service it was just told to stop.
+// The marker is consumed by superviseOne if that process is still around,
+// or else cleared the next time the service is (re-)started via Up.
+func Down(stateDir string) error {
+	entries, err := os.ReadDir(stateDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("read supervisor state dir %s: %w", stateDir, err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".pid") {
+			continue
+		}
+
+		name := strings.TrimSuffix(entry.Name(), ".pid")
+		pidPath := filepath.Join(stateDir, entry.Name())
+
+		if err := os.WriteFile(stopMarkerPath(stateDir, name), []byte{}, 0o644); err != nil {
+			fmt.Printf("⚠ %s: failed to write stop marker: %v\n", name, err)
+		}
+
+		raw, err := os.ReadFile(pidPath)
+		if err != nil {
+			continue
+		}
+		pid, err := strconv.Atoi(strings.TrimSpace(string(raw)))
+		if err != nil {
+			os.Remove(pidPath)
+			continue
+		}
+
+		if err := stopProcess(name, pid); err != nil {
+			fmt.Printf("⚠ %s (pid %d): %v\n", name, pid, err)
+		}
+		os.Remove(pidPath)
+	}
+
+	return nil
+}
+
+// stopProcess sends SIGTERM to pid and escalates to SIGKILL if it's still
+// alive after shutdownGrace.
+func stopProcess(name string, pid int) error {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return nil
+	}
+
+	if err := proc.Signal(syscall.SIGTERM); err != nil {
+		if err == os.ErrProcessDone {
+			return nil
+		}
+		// Process is probably already gone; nothing left to escalate to.
+		return nil
+	}
+	fmt.Printf("→ Sent SIGTERM to %s (pid %d)\n", name, pid)
+
+	deadline := time.Now().Add(shutdownGrace)
+	for time.Now().Before(deadline) {
+		if proc.Signal(syscall.Signal(0)) != nil {
+			fmt.Printf("✓ %s stopped\n", name)
+			return nil
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+
+	fmt.Printf("→ %s still running after %s; sending SIGKILL\n", name, shutdownGrace)
+	return proc.Signal(syscall.SIGKILL)
+}
+
+// waitHealthy polls spec.HealthCheck until it passes or Timeout elapses.
+func waitHealthy(ctx context.Context, spec ServiceSpec) error {
+	hc := spec.HealthCheck
+	interval := hc.Interval
+	if interval == 0 {
+		interval = 1 * time.Second
+	}
+	timeout := hc.Timeout
+	if timeout == 0 {
+		timeout = 60 * time.Second
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		if healthCheckPasses(checkCtx, hc) {
+			fmt.Printf("✓ %s healthy\n", spec.Name)
+			return nil
+		}
+		select {
+		case <-checkCtx.Done():
+			return fmt.Errorf("timed out after %s", timeout)
+		case <-time.After(interval):
+		}
+	}
+}
+
+func healthCheckPasses(ctx context.Context, hc *HealthCheck) bool {
+	if hc.URL != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, hc.URL, nil)
+		if err != nil {
+			return false
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		return resp.StatusCode >= 200 && resp.StatusCode < 300
+	}
+	if hc.Command != "" {
+		cmd := exec.CommandContext(ctx, "sh", "-c", hc.Command)
+		return cmd.Run() == nil
+	}
+	return true
+}
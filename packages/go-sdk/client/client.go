@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/madfam-org/janua/packages/go-sdk/auth"
@@ -20,6 +21,13 @@ type Client struct {
 	apiKey     string
 	httpClient *http.Client
 	auth       *auth.Manager
+
+	disableAutoRefresh bool
+	onTokenRefresh     func(*models.AuthResponse)
+	// refreshGroup coalesces concurrent refreshes (proactive, from
+	// auth.Manager.NeedsRefresh, and reactive, from a 401 response) into a
+	// single call to /auth/refresh.
+	refreshGroup sfGroup
 }
 
 // Config holds the client configuration
@@ -28,6 +36,10 @@ type Config struct {
 	APIKey     string
 	HTTPClient *http.Client
 	Timeout    time.Duration
+	// DisableAutoRefresh turns off the client's automatic token refresh
+	// (both the proactive refresh ahead of expiry and the retry-on-401),
+	// for applications that want to manage refreshing themselves.
+	DisableAutoRefresh bool
 }
 
 // New creates a new Janua client
@@ -47,13 +59,22 @@ func New(config Config) *Client {
 	}
 
 	return &Client{
-		baseURL:    config.BaseURL,
-		apiKey:     config.APIKey,
-		httpClient: config.HTTPClient,
-		auth:       auth.NewManager(config.APIKey),
+		baseURL:            config.BaseURL,
+		apiKey:             config.APIKey,
+		httpClient:         config.HTTPClient,
+		auth:               auth.NewManager(config.APIKey),
+		disableAutoRefresh: config.DisableAutoRefresh,
 	}
 }
 
+// OnTokenRefresh registers a callback invoked whenever the client rotates
+// its tokens via an automatic refresh (proactive or retry-on-401), so
+// applications can persist the new tokens. Only one callback is kept; a
+// later call replaces an earlier one.
+func (c *Client) OnTokenRefresh(fn func(*models.AuthResponse)) {
+	c.onTokenRefresh = fn
+}
+
 // SignIn authenticates a user with email and password
 func (c *Client) SignIn(ctx context.Context, email, password string) (*models.AuthResponse, error) {
 	payload := map[string]string{
@@ -69,7 +90,7 @@ func (c *Client) SignIn(ctx context.Context, email, password string) (*models.Au
 
 	// Store the tokens in the auth manager
 	c.auth.SetTokens(response.AccessToken, response.RefreshToken)
-	
+
 	return &response, nil
 }
 
@@ -82,7 +103,7 @@ func (c *Client) SignUp(ctx context.Context, req *models.SignUpRequest) (*models
 	}
 
 	c.auth.SetTokens(response.AccessToken, response.RefreshToken)
-	
+
 	return &response, nil
 }
 
@@ -163,30 +184,79 @@ func (c *Client) delete(ctx context.Context, path string) error {
 }
 
 func (c *Client) request(ctx context.Context, method, path string, body interface{}, result interface{}) error {
-	u, err := url.Parse(c.baseURL)
+	if !c.disableAutoRefresh && c.auth.NeedsRefresh() && c.auth.GetRefreshToken() != "" {
+		// A failed proactive refresh isn't fatal on its own - the current
+		// access token may still be valid for a few more minutes, so
+		// ignore the error here and let the request (and its
+		// retry-on-401 below) decide.
+		_, _ = c.refreshGroup.do("refresh", func() (interface{}, error) {
+			return nil, c.refreshTokens(ctx)
+		})
+	}
+
+	resp, err := c.doRequest(ctx, method, path, body)
 	if err != nil {
 		return err
 	}
+
+	if resp.StatusCode == http.StatusUnauthorized && !c.disableAutoRefresh && c.auth.GetRefreshToken() != "" {
+		resp.Body.Close()
+		if _, refreshErr := c.refreshGroup.do("refresh", func() (interface{}, error) {
+			return nil, c.refreshTokens(ctx)
+		}); refreshErr == nil {
+			resp, err = c.doRequest(ctx, method, path, body)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	defer resp.Body.Close()
+
+	// Check for errors
+	if resp.StatusCode >= 400 {
+		var apiErr models.APIError
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err != nil {
+			return fmt.Errorf("API error: %s", resp.Status)
+		}
+		return &apiErr
+	}
+
+	// Decode response if result is provided
+	if result != nil {
+		return json.NewDecoder(resp.Body).Decode(result)
+	}
+
+	return nil
+}
+
+// doRequest builds and sends a single HTTP request; it does not retry or
+// refresh tokens, so refreshTokens can safely use it without recursing
+// through request's refresh logic.
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, err
+	}
 	u.Path = "/api/v1" + path
 
 	var bodyReader io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		bodyReader = bytes.NewReader(jsonBody)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Set headers
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-	
+
 	// Add API key if available
 	if c.apiKey != "" {
 		req.Header.Set("X-API-Key", c.apiKey)
@@ -197,25 +267,82 @@ func (c *Client) request(ctx context.Context, method, path string, body interfac
 		req.Header.Set("Authorization", "Bearer "+token)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	return c.httpClient.Do(req)
+}
+
+// refreshTokens exchanges the manager's stored refresh token for a new
+// token pair, updates the manager, and notifies onTokenRefresh if set.
+// Callers are expected to have already coalesced concurrent calls through
+// refreshGroup.
+func (c *Client) refreshTokens(ctx context.Context) error {
+	refreshToken := c.auth.GetRefreshToken()
+	if refreshToken == "" {
+		return fmt.Errorf("no refresh token available")
+	}
+
+	payload := map[string]string{"refresh_token": refreshToken}
+	resp, err := c.doRequest(ctx, http.MethodPost, "/auth/refresh", payload)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	// Check for errors
 	if resp.StatusCode >= 400 {
 		var apiErr models.APIError
 		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err != nil {
-			return fmt.Errorf("API error: %s", resp.Status)
+			return fmt.Errorf("refresh failed: %s", resp.Status)
 		}
 		return &apiErr
 	}
 
-	// Decode response if result is provided
-	if result != nil {
-		return json.NewDecoder(resp.Body).Decode(result)
+	var response models.AuthResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return err
 	}
 
+	c.auth.SetTokens(response.AccessToken, response.RefreshToken)
+	if c.onTokenRefresh != nil {
+		c.onTokenRefresh(&response)
+	}
 	return nil
-}
\ No newline at end of file
+}
+
+// sfGroup is a minimal single-flight group: concurrent do() calls sharing a
+// key wait for the first caller's fn to finish and share its result, rather
+// than each running fn themselves. This keeps concurrent proactive and
+// reactive refreshes from stampeding the token refresh endpoint.
+type sfGroup struct {
+	mu    sync.Mutex
+	calls map[string]*sfCall
+}
+
+type sfCall struct {
+	done   chan struct{}
+	result interface{}
+	err    error
+}
+
+func (g *sfGroup) do(key string, fn func() (interface{}, error)) (interface{}, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*sfCall)
+	}
+	if call, inFlight := g.calls[key]; inFlight {
+		g.mu.Unlock()
+		<-call.done
+		return call.result, call.err
+	}
+
+	call := &sfCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.result, call.err = fn()
+	close(call.done)
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+
+	return call.result, call.err
+}
@@ -0,0 +1,92 @@
+package janua
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// APIKeysService handles API key management operations
+type APIKeysService struct {
+	client *Client
+}
+
+// APIKey represents a Janua API key. Key only appears in the response to
+// CreateAPIKey; subsequent reads only ever return Prefix.
+type APIKey struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Key        string     `json:"key,omitempty"`
+	Scopes     []string   `json:"scopes,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreateAPIKeyRequest represents an API key creation request
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// CreateAPIKey creates a new API key. The returned APIKey.Key is only
+// available here and cannot be retrieved again.
+func (s *APIKeysService) CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*APIKey, error) {
+	resp, err := s.client.request(ctx, http.MethodPost, "/api/v1/api-keys", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var key APIKey
+	if err := decodeResponse(resp, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// ListAPIKeys lists API keys
+func (s *APIKeysService) ListAPIKeys(ctx context.Context, opts *ListOptions) (*Paginated[APIKey], error) {
+	path := appendQuery("/api/v1/api-keys", opts.values())
+
+	resp, err := s.client.request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Paginated[APIKey]
+	if err := decodeResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RotateAPIKey revokes keyID and issues a new key with the same name and
+// scopes. The returned APIKey.Key is only available here.
+func (s *APIKeysService) RotateAPIKey(ctx context.Context, keyID string) (*APIKey, error) {
+	resp, err := s.client.request(ctx, http.MethodPost, fmt.Sprintf("/api/v1/api-keys/%s/rotate", keyID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var key APIKey
+	if err := decodeResponse(resp, &key); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// RevokeAPIKey revokes an API key
+func (s *APIKeysService) RevokeAPIKey(ctx context.Context, keyID string) error {
+	resp, err := s.client.request(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/api-keys/%s", keyID), nil)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(resp, nil)
+}
@@ -0,0 +1,74 @@
+package janua
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// AuditEventsService queries the audit trail recorded by the Janua API (see
+// AuditLog). Listing uses cursor pagination rather than ListOptions since
+// the log is append-only and continuously growing, so an offset-based page
+// number would drift as new events are recorded between requests.
+type AuditEventsService struct {
+	client *Client
+}
+
+// AuditEventsListOptions filters AuditEventsService.List.
+type AuditEventsListOptions struct {
+	Cursor   string    `url:"cursor,omitempty"`
+	Limit    int       `url:"limit,omitempty"`
+	ActorID  string    `url:"actor_id,omitempty"`
+	TargetID string    `url:"target_id,omitempty"`
+	Action   string    `url:"action,omitempty"`
+	Since    time.Time `url:"-"`
+	Until    time.Time `url:"-"`
+}
+
+// List returns a page of audit events matching opts, most recent first.
+// Pass the returned CursorPage.NextCursor as the next call's Cursor to page
+// through the rest while CursorPage.HasMore is true.
+func (s *AuditEventsService) List(ctx context.Context, opts *AuditEventsListOptions) (*CursorPage[AuditLog], error) {
+	path := "/api/v1/audit-events"
+	if opts != nil {
+		q := url.Values{}
+		if opts.Cursor != "" {
+			q.Set("cursor", opts.Cursor)
+		}
+		if opts.Limit > 0 {
+			q.Set("limit", fmt.Sprintf("%d", opts.Limit))
+		}
+		if opts.ActorID != "" {
+			q.Set("actor_id", opts.ActorID)
+		}
+		if opts.TargetID != "" {
+			q.Set("target_id", opts.TargetID)
+		}
+		if opts.Action != "" {
+			q.Set("action", opts.Action)
+		}
+		if !opts.Since.IsZero() {
+			q.Set("since", opts.Since.Format(time.RFC3339))
+		}
+		if !opts.Until.IsZero() {
+			q.Set("until", opts.Until.Format(time.RFC3339))
+		}
+		if encoded := q.Encode(); encoded != "" {
+			path = fmt.Sprintf("%s?%s", path, encoded)
+		}
+	}
+
+	resp, err := s.client.request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CursorPage[AuditLog]
+	if err := decodeResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
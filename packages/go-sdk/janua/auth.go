@@ -3,12 +3,21 @@ package janua
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"sync"
 )
 
 // AuthService handles authentication operations
 type AuthService struct {
 	client *Client
+
+	// pkceMu guards pkceVerifiers, which GetOAuthURL writes to and
+	// HandleOAuthCallback reads from and clears, keyed by the flow's
+	// state parameter.
+	pkceMu        sync.Mutex
+	pkceVerifiers map[string]string
 }
 
 // SignUpRequest represents a sign-up request
@@ -34,8 +43,29 @@ type AuthResponse struct {
 	User  *User         `json:"user"`
 	Token *Token        `json:"token"`
 	MFA   *MFAChallenge `json:"mfa,omitempty"`
+	// IDToken is the OIDC ID token issued alongside the access/refresh
+	// pair, when the server is configured as an OIDC provider. Verify it
+	// with an IDTokenVerifier before trusting its claims.
+	IDToken string `json:"id_token,omitempty"`
 }
 
+// TokenType identifies the kind of a token beyond the simple
+// access/refresh pair, matching the categories richer auth servers issue:
+// a bearer access token, a refresh token, an OIDC ID token, a
+// narrowly-scoped management token for admin-panel access, a one-time
+// signin (magic-link) token, or an actor token minted for impersonation
+// via ExchangeToken.
+type TokenType string
+
+const (
+	TokenTypeAccess     TokenType = "access"
+	TokenTypeRefresh    TokenType = "refresh"
+	TokenTypeID         TokenType = "id_token"
+	TokenTypeManagement TokenType = "management"
+	TokenTypeSignin     TokenType = "signin"
+	TokenTypeActor      TokenType = "actor"
+)
+
 // MFAChallenge represents an MFA challenge
 type MFAChallenge struct {
 	ChallengeID string   `json:"challenge_id"`
@@ -99,7 +129,13 @@ func (s *AuthService) SignOut(ctx context.Context, refreshToken string) error {
 	return decodeResponse(resp, nil)
 }
 
-// RefreshToken refreshes an access token
+// RefreshToken refreshes an access token. If refreshToken was already
+// rotated (i.e. it's no longer the current token in its
+// RefreshTokenFamily), the server treats this as reuse: it revokes every
+// session descended from that family, records an AuditLog entry with
+// action AuditActionRefreshTokenReuseDetected, and this call returns a
+// *TokenReuseError. Callers should treat that as a signal to force a fresh
+// sign-in rather than retrying.
 func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (*Token, error) {
 	req := map[string]string{
 		"refresh_token": refreshToken,
@@ -110,6 +146,15 @@ func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (*T
 		return nil, err
 	}
 
+	// Parsed through the richer JanuaError taxonomy (rather than
+	// decodeResponse's plain APIError) so reuse detection surfaces as a
+	// *TokenReuseError callers can type-switch on.
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, ParseAPIError(resp, body)
+	}
+
 	var token Token
 	if err := decodeResponse(resp, &token); err != nil {
 		return nil, err
@@ -249,9 +294,36 @@ type OAuthRequest struct {
 	Scopes      []string `json:"scopes,omitempty"`
 }
 
-// GetOAuthURL gets the OAuth authorization URL
+// GetOAuthURL gets the OAuth authorization URL. It generates a PKCE code
+// verifier and challenge (RFC 7636, "S256" method) and stores the verifier
+// against req.State (generating one via GenerateState if not set), so
+// HandleOAuthCallback can complete the exchange as a public client, without
+// embedding a client secret.
 func (s *AuthService) GetOAuthURL(ctx context.Context, req *OAuthRequest) (string, error) {
-	resp, err := s.client.request(ctx, http.MethodGet, fmt.Sprintf("/api/v1/auth/oauth/%s/authorize", req.Provider), nil)
+	state := req.State
+	if state == "" {
+		state = GenerateState()
+	}
+
+	verifier := GenerateCodeVerifier()
+	s.pkceMu.Lock()
+	if s.pkceVerifiers == nil {
+		s.pkceVerifiers = make(map[string]string)
+	}
+	s.pkceVerifiers[state] = verifier
+	s.pkceMu.Unlock()
+
+	q := url.Values{}
+	q.Set("redirect_uri", req.RedirectURI)
+	q.Set("state", state)
+	q.Set("code_challenge", GenerateCodeChallenge(verifier))
+	q.Set("code_challenge_method", "S256")
+	for _, scope := range req.Scopes {
+		q.Add("scope", scope)
+	}
+
+	path := fmt.Sprintf("/api/v1/auth/oauth/%s/authorize?%s", req.Provider, q.Encode())
+	resp, err := s.client.request(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return "", err
 	}
@@ -266,12 +338,25 @@ func (s *AuthService) GetOAuthURL(ctx context.Context, req *OAuthRequest) (strin
 	return result.URL, nil
 }
 
-// HandleOAuthCallback handles the OAuth callback
+// HandleOAuthCallback handles the OAuth callback. If GetOAuthURL generated
+// a PKCE verifier for this state, it's sent as code_verifier and removed
+// from the pending set; a state with no matching verifier (e.g. a flow
+// started without PKCE) completes the exchange without one.
 func (s *AuthService) HandleOAuthCallback(ctx context.Context, code, state string) (*AuthResponse, error) {
+	s.pkceMu.Lock()
+	verifier, ok := s.pkceVerifiers[state]
+	if ok {
+		delete(s.pkceVerifiers, state)
+	}
+	s.pkceMu.Unlock()
+
 	req := map[string]string{
 		"code":  code,
 		"state": state,
 	}
+	if ok {
+		req["code_verifier"] = verifier
+	}
 
 	resp, err := s.client.request(ctx, http.MethodPost, "/api/v1/auth/oauth/callback", req)
 	if err != nil {
@@ -291,6 +376,100 @@ func (s *AuthService) HandleOAuthCallback(ctx context.Context, code, state strin
 	return &authResp, nil
 }
 
+// IssueSigninToken emails a one-time magic-link sign-in token to email.
+// The token itself is delivered out-of-band; the caller redeems it with
+// SignInWithToken.
+func (s *AuthService) IssueSigninToken(ctx context.Context, email string) error {
+	req := map[string]string{
+		"email": email,
+	}
+
+	resp, err := s.client.request(ctx, http.MethodPost, "/api/v1/auth/signin/token/issue", req)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(resp, nil)
+}
+
+// SignInWithToken redeems a magic-link token issued by IssueSigninToken.
+func (s *AuthService) SignInWithToken(ctx context.Context, token string) (*AuthResponse, error) {
+	req := map[string]string{
+		"token": token,
+	}
+
+	resp, err := s.client.request(ctx, http.MethodPost, "/api/v1/auth/signin/token", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var authResp AuthResponse
+	if err := decodeResponse(resp, &authResp); err != nil {
+		return nil, err
+	}
+
+	// Auto-store tokens
+	if authResp.Token != nil {
+		s.client.SetTokens(authResp.Token)
+	}
+
+	return &authResp, nil
+}
+
+// IssueManagementToken issues a Token of type TokenTypeManagement, scoped
+// to scopes, for accessing the admin panel's API. Unlike SignIn and
+// friends, the returned token is not auto-stored on the client - it's
+// meant to be used for a narrower set of calls than the caller's own
+// session, not to replace it.
+func (s *AuthService) IssueManagementToken(ctx context.Context, scopes []string) (*Token, error) {
+	req := map[string]interface{}{
+		"scopes": scopes,
+	}
+
+	resp, err := s.client.request(ctx, http.MethodPost, "/api/v1/auth/tokens/management", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var token Token
+	if err := decodeResponse(resp, &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// TokenExchangeRequest is an RFC 8693 token exchange request, used by
+// ExchangeToken to obtain an actor token for impersonation: subject_token
+// identifies the user being acted on behalf of, and actor_token identifies
+// the caller doing the impersonating.
+type TokenExchangeRequest struct {
+	SubjectToken       string    `json:"subject_token"`
+	SubjectTokenType   TokenType `json:"subject_token_type,omitempty"`
+	ActorToken         string    `json:"actor_token,omitempty"`
+	ActorTokenType     TokenType `json:"actor_token_type,omitempty"`
+	RequestedTokenType TokenType `json:"requested_token_type,omitempty"`
+	Scope              []string  `json:"scope,omitempty"`
+}
+
+// ExchangeToken implements RFC 8693 token exchange, trading the tokens in
+// req for a new Token of req.RequestedTokenType (TokenTypeActor for
+// impersonation). As with IssueManagementToken, the result is not
+// auto-stored on the client.
+func (s *AuthService) ExchangeToken(ctx context.Context, req *TokenExchangeRequest) (*Token, error) {
+	resp, err := s.client.request(ctx, http.MethodPost, "/api/v1/auth/tokens/exchange", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var token Token
+	if err := decodeResponse(resp, &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
 // PasskeyRegistrationRequest represents a passkey registration request
 type PasskeyRegistrationRequest struct {
 	UserID      string `json:"user_id,omitempty"`
@@ -4,11 +4,21 @@ package janua
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -27,13 +37,102 @@ type Client struct {
 	httpClient *http.Client
 	apiKey     string
 	tenantID   string
-	
+	// mtls is true once a client certificate has been installed on the
+	// transport (via Config.ClientCertPEM/ClientKeyPEM or
+	// WithClientCertificate/WithClientCertificateFile), so buildRequest
+	// authenticates with X-Client-Auth instead of a bearer token.
+	mtls bool
+
+	// tokenMu guards accessToken/refreshToken, which change concurrently
+	// with in-flight requests whenever SetTokens/ClearTokens or the
+	// refreshing transport's automatic refresh run.
+	tokenMu      sync.RWMutex
+	accessToken  string
+	refreshToken string
+
 	// Services
 	Auth          *AuthService
 	Users         *UsersService
 	Organizations *OrganizationsService
 	Sessions      *SessionsService
 	Webhooks      *WebhooksService
+	MFA           *MFAService
+	Tenants       *TenantsService
+	APIKeys       *APIKeysService
+	AuditEvents   *AuditEventsService
+	Permissions   *PermissionsService
+	OIDC          *OIDCService
+}
+
+// SetTokens stores the access and refresh tokens from an AuthResponse (or a
+// RefreshToken call) so subsequent requests authenticate as the signed-in
+// user, and so the refreshing transport can use RefreshToken to obtain a
+// new access token once this one expires or is rejected.
+func (c *Client) SetTokens(token *Token) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.accessToken = token.AccessToken
+	c.refreshToken = token.RefreshToken
+}
+
+// ClearTokens discards any stored session tokens, reverting to apiKey (if
+// set) for authentication.
+func (c *Client) ClearTokens() {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.accessToken = ""
+	c.refreshToken = ""
+}
+
+// currentAccessToken returns the stored session access token, falling back
+// to the client's static API key.
+func (c *Client) currentAccessToken() string {
+	c.tokenMu.RLock()
+	defer c.tokenMu.RUnlock()
+	if c.accessToken != "" {
+		return c.accessToken
+	}
+	return c.apiKey
+}
+
+// currentRefreshToken returns the stored session refresh token, or "" if
+// the client isn't authenticated as a user (e.g. it only has an API key).
+func (c *Client) currentRefreshToken() string {
+	c.tokenMu.RLock()
+	defer c.tokenMu.RUnlock()
+	return c.refreshToken
+}
+
+// WithToken returns a shallow copy of c authenticated as accessToken
+// instead of c's own stored session/API key credentials. The copy shares
+// c's httpClient, but its services are re-bound to the copy so e.g.
+// copy.Users.GetCurrentUser resolves whoever accessToken belongs to. It
+// doesn't auto-refresh (there's no refresh token for a bearer token we
+// didn't issue ourselves) - used to check permissions against a caller's
+// own token rather than the wrapping Client's identity.
+func (c *Client) WithToken(accessToken string) *Client {
+	clone := &Client{
+		baseURL:    c.baseURL,
+		httpClient: c.httpClient,
+		apiKey:     c.apiKey,
+		tenantID:   c.tenantID,
+		mtls:       c.mtls,
+	}
+	clone.accessToken = accessToken
+
+	clone.Auth = &AuthService{client: clone}
+	clone.Users = &UsersService{client: clone}
+	clone.Organizations = &OrganizationsService{client: clone}
+	clone.Sessions = &SessionsService{client: clone, resilient: c.Sessions.resilient}
+	clone.Webhooks = &WebhooksService{client: clone}
+	clone.MFA = &MFAService{client: clone}
+	clone.Tenants = &TenantsService{client: clone}
+	clone.APIKeys = &APIKeysService{client: clone}
+	clone.AuditEvents = &AuditEventsService{client: clone}
+	clone.Permissions = &PermissionsService{client: clone}
+	clone.OIDC = &OIDCService{client: clone}
+
+	return clone
 }
 
 // Config holds the configuration for the Janua client
@@ -42,10 +141,27 @@ type Config struct {
 	APIKey   string
 	TenantID string
 	Timeout  time.Duration
+	// Retry configures the RetryTransport wrapped around the underlying
+	// http.Client transport. Nil disables the retrying transport.
+	Retry *RetryTransportConfig
+
+	// ClientCertPEM and ClientKeyPEM authenticate the SDK to Janua via
+	// mTLS using an in-memory PEM-encoded certificate/key pair, instead
+	// of a bearer token - for service-to-service clients (agents,
+	// bouncers, CI runners) enrolled with a platform-issued cert rather
+	// than a long-lived API key. Equivalent to passing
+	// WithClientCertificate as a ClientOption.
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	// RootCAsPEM overrides the system root CA pool used to verify the
+	// Janua API's server certificate. Equivalent to passing WithRootCAs.
+	RootCAsPEM []byte
 }
 
-// NewClient creates a new Janua client
-func NewClient(config *Config) *Client {
+// NewClient creates a new Janua client. Additional ClientOptions, such as
+// WithClientCertificate for mTLS authentication, may be passed to customize
+// the underlying http.Client's transport.
+func NewClient(config *Config, opts ...ClientOption) (*Client, error) {
 	if config.BaseURL == "" {
 		config.BaseURL = DefaultBaseURL
 	}
@@ -53,29 +169,103 @@ func NewClient(config *Config) *Client {
 		config.Timeout = DefaultTimeout
 	}
 
+	var options clientOptions
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(config.ClientCertPEM) > 0 && len(config.ClientKeyPEM) > 0 {
+		cert, err := tls.X509KeyPair(config.ClientCertPEM, config.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("janua: parse client certificate: %w", err)
+		}
+		tlsConfig := options.ensureTLSConfig()
+		tlsConfig.Certificates = append(tlsConfig.Certificates, cert)
+	}
+	if len(config.RootCAsPEM) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(config.RootCAsPEM) {
+			return nil, fmt.Errorf("janua: parse root CAs: no certificates found")
+		}
+		options.ensureTLSConfig().RootCAs = pool
+	}
+
 	httpClient := &http.Client{
 		Timeout: config.Timeout,
 	}
 
+	var transport http.RoundTripper = http.DefaultTransport
+	if options.tlsConfig != nil {
+		transport = &http.Transport{TLSClientConfig: options.tlsConfig}
+	}
+
 	c := &Client{
 		baseURL:    config.BaseURL,
 		httpClient: httpClient,
 		apiKey:     config.APIKey,
 		tenantID:   config.TenantID,
+		mtls:       options.tlsConfig != nil && len(options.tlsConfig.Certificates) > 0,
+	}
+
+	// Every request goes through the refreshing transport first, so a 401
+	// triggers one token refresh (single-flighted across concurrent
+	// requests) and retry before the error ever reaches the caller. Retries
+	// for transient failures, if configured, happen one layer further out.
+	transport = newRefreshingTransport(transport, c)
+	if config.Retry != nil {
+		transport = NewRetryTransport(transport, config.Retry)
 	}
+	httpClient.Transport = transport
 
 	// Initialize services
 	c.Auth = &AuthService{client: c}
 	c.Users = &UsersService{client: c}
 	c.Organizations = &OrganizationsService{client: c}
-	c.Sessions = &SessionsService{client: c}
+	c.Sessions = &SessionsService{
+		client: c,
+		// Revocation is security-sensitive and often called during an
+		// incident (e.g. responding to detected token reuse), so it gets
+		// its own retry policy plus a circuit breaker rather than relying
+		// solely on the transport-level RetryTransport.
+		resilient: NewRetryableClient(httpClient, DefaultRetryConfig(), WithCircuitBreaker(NewCircuitBreaker(nil))),
+	}
 	c.Webhooks = &WebhooksService{client: c}
-
-	return c
+	c.MFA = &MFAService{client: c}
+	c.Tenants = &TenantsService{client: c}
+	c.APIKeys = &APIKeysService{client: c}
+	c.AuditEvents = &AuditEventsService{client: c}
+	c.Permissions = &PermissionsService{client: c}
+	c.OIDC = &OIDCService{client: c}
+
+	return c, nil
 }
 
 // request performs an HTTP request
 func (c *Client) request(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	req, err := c.buildRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return c.httpClient.Do(req)
+}
+
+// requestVia behaves like request but sends the built request through rc
+// instead of the client's plain http.Client. Used by calls that want their
+// own retry/circuit-breaker policy layered on top of the client's own
+// RetryTransport, such as SessionsService's revocation endpoints.
+func (c *Client) requestVia(ctx context.Context, method, path string, body interface{}, rc *RetryableClient) (*http.Response, error) {
+	req, err := c.buildRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	return rc.Do(req)
+}
+
+// buildRequest constructs an authenticated JSON request against the
+// client's base URL.
+func (c *Client) buildRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
 	u, err := url.Parse(c.baseURL)
 	if err != nil {
 		return nil, err
@@ -101,14 +291,38 @@ func (c *Client) request(ctx context.Context, method, path string, body interfac
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", fmt.Sprintf("janua-go/%s", Version))
 
-	if c.apiKey != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	c.setAuthHeaders(req)
+
+	if body != nil {
+		req.GetBody = func() (io.ReadCloser, error) {
+			jsonBody, err := json.Marshal(body)
+			if err != nil {
+				return nil, err
+			}
+			return io.NopCloser(bytes.NewBuffer(jsonBody)), nil
+		}
+	}
+
+	return req, nil
+}
+
+// setAuthHeaders sets req's Authorization/X-Client-Auth and X-Tenant-ID
+// headers from c's configured auth mode: a client certificate presented
+// during the TLS handshake (mTLS), a bearer token (stored session token,
+// falling back to the static API key), or neither. Shared by buildRequest
+// and streamRequest so the two request paths can't drift on how a client
+// authenticates.
+func (c *Client) setAuthHeaders(req *http.Request) {
+	if c.mtls {
+		// The backend authenticates the caller by the client certificate
+		// presented during the TLS handshake, not a bearer token.
+		req.Header.Set("X-Client-Auth", "mtls")
+	} else if token := c.currentAccessToken(); token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 	}
 	if c.tenantID != "" {
 		req.Header.Set("X-Tenant-ID", c.tenantID)
 	}
-
-	return c.httpClient.Do(req)
 }
 
 // decodeResponse decodes the JSON response
@@ -169,15 +383,15 @@ type Organization struct {
 
 // Session represents a user session
 type Session struct {
-	ID           string    `json:"id"`
-	UserID       string    `json:"user_id"`
-	TenantID     string    `json:"tenant_id"`
+	ID           string     `json:"id"`
+	UserID       string     `json:"user_id"`
+	TenantID     string     `json:"tenant_id"`
 	DeviceInfo   DeviceInfo `json:"device_info"`
-	IP           string    `json:"ip"`
-	UserAgent    string    `json:"user_agent"`
-	LastActivity time.Time `json:"last_activity"`
-	ExpiresAt    time.Time `json:"expires_at"`
-	CreatedAt    time.Time `json:"created_at"`
+	IP           string     `json:"ip"`
+	UserAgent    string     `json:"user_agent"`
+	LastActivity time.Time  `json:"last_activity"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	CreatedAt    time.Time  `json:"created_at"`
 }
 
 // DeviceInfo contains device information for a session
@@ -194,6 +408,20 @@ type Token struct {
 	RefreshToken string `json:"refresh_token"`
 	TokenType    string `json:"token_type"`
 	ExpiresIn    int    `json:"expires_in"`
+	// Family identifies the refresh token family this token belongs to, so
+	// reuse of a rotated refresh token can be traced back to the session it
+	// started from. Omitted by servers that don't implement rotation.
+	Family *RefreshTokenFamily `json:"family,omitempty"`
+}
+
+// RefreshTokenFamily tracks refresh token rotation lineage: each time a
+// refresh token is exchanged, the new token records the token it replaced
+// as its Parent. A server detecting that a non-current token in the chain
+// was reused revokes every session descended from FamilyID and reports a
+// TokenReuseError.
+type RefreshTokenFamily struct {
+	FamilyID string `json:"family_id"`
+	ParentID string `json:"parent_id,omitempty"`
 }
 
 // Claims represents JWT claims
@@ -204,6 +432,9 @@ type Claims struct {
 	Email    string                 `json:"email"`
 	Roles    []string               `json:"roles,omitempty"`
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Nonce carries the OIDC nonce an authorization request supplied, so
+	// IDTokenVerifier.Verify can check it against VerifyOptions.Nonce.
+	Nonce string `json:"nonce,omitempty"`
 }
 
 // VerifyToken verifies a JWT token
@@ -240,6 +471,19 @@ type Paginated[T any] struct {
 	Page       int `json:"page"`
 	PerPage    int `json:"per_page"`
 	TotalPages int `json:"total_pages"`
+	// NextCursor, when non-empty, can be passed as ListOptions.Cursor to
+	// fetch the next page by cursor instead of Page/PerPage, which holds
+	// up better against concurrent inserts on large tenants.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// CursorPage represents a cursor-paginated response, used by endpoints
+// (like AuditEventsService.List) where offset-based paging over a
+// continuously-growing, append-only log isn't meaningful.
+type CursorPage[T any] struct {
+	Data       []T    `json:"data"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
 }
 
 // ListOptions represents options for list operations
@@ -249,6 +493,47 @@ type ListOptions struct {
 	Sort    string `url:"sort,omitempty"`
 	Order   string `url:"order,omitempty"`
 	Search  string `url:"search,omitempty"`
+	// Cursor, if set, fetches the page following a prior response's
+	// Paginated.NextCursor instead of Page.
+	Cursor string `url:"cursor,omitempty"`
+}
+
+// values encodes o as URL query parameters, properly escaping each value
+// (unlike building the query string with fmt.Sprintf, which breaks on
+// search terms or sort fields containing spaces, '&', or unicode).
+func (o *ListOptions) values() url.Values {
+	v := url.Values{}
+	if o == nil {
+		return v
+	}
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	if o.Sort != "" {
+		v.Set("sort", o.Sort)
+	}
+	if o.Order != "" {
+		v.Set("order", o.Order)
+	}
+	if o.Search != "" {
+		v.Set("search", o.Search)
+	}
+	if o.Cursor != "" {
+		v.Set("cursor", o.Cursor)
+	}
+	return v
+}
+
+// appendQuery appends v's encoded query string to path, if v has any
+// parameters set.
+func appendQuery(path string, v url.Values) string {
+	if len(v) == 0 {
+		return path
+	}
+	return path + "?" + v.Encode()
 }
 
 // WebhookEvent represents a webhook event
@@ -260,11 +545,34 @@ type WebhookEvent struct {
 	Signature string                 `json:"signature"`
 }
 
-// VerifyWebhookSignature verifies a webhook signature
+// VerifyWebhookSignature verifies a webhook signature. signature may be a
+// combined "t=<unix-timestamp>,v1=<hex-hmac>" header (see VerifyWebhook,
+// which this delegates to and which rejects signatures older than its
+// default 5-minute tolerance), or a bare hex/base64-encoded HMAC-SHA256 of
+// payload for integrations that don't send a timestamp — in which case no
+// replay protection is possible, since there's nothing to bound the
+// signature's age by.
 func VerifyWebhookSignature(payload []byte, signature string, secret string) bool {
-	// Implementation would verify HMAC signature
-	// This is a placeholder
-	return true
+	if strings.Contains(signature, "v1=") {
+		return VerifyWebhook(secret, signature, payload, nil) == nil
+	}
+	return verifyBareSignature(payload, signature, secret)
+}
+
+// verifyBareSignature compares signature (hex or base64-encoded) against
+// HMAC_SHA256(secret, payload) using hmac.Equal.
+func verifyBareSignature(payload []byte, signature string, secret string) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	if decoded, err := hex.DecodeString(signature); err == nil {
+		return hmac.Equal(decoded, expected)
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(signature); err == nil {
+		return hmac.Equal(decoded, expected)
+	}
+	return false
 }
 
 // GenerateState generates a random state for OAuth flows
@@ -272,15 +580,32 @@ func GenerateState() string {
 	return uuid.New().String()
 }
 
-// GenerateCodeVerifier generates a PKCE code verifier
+// GenerateCodeVerifier generates a PKCE code verifier per RFC 7636 §4.1: 32
+// random bytes, base64url-encoded without padding, yielding a 43-character
+// string drawn from the spec's unreserved character set.
 func GenerateCodeVerifier() string {
-	// Generate random 32-byte string
-	return uuid.New().String() + uuid.New().String()
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		// crypto/rand.Read only fails if the OS CSPRNG is unavailable,
+		// which would make every other use of this package equally
+		// broken; a panic here surfaces that loudly instead of silently
+		// handing out a predictable verifier.
+		panic(fmt.Sprintf("janua: failed to read random bytes: %v", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
 }
 
-// GenerateCodeChallenge generates a PKCE code challenge from a verifier
+// GenerateCodeChallenge computes the PKCE "S256" code challenge for
+// verifier: base64url(SHA256(verifier)) without padding, per RFC 7636 §4.2.
 func GenerateCodeChallenge(verifier string) string {
-	// This would implement S256 challenge generation
-	// Placeholder for now
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// GenerateCodeChallengePlain returns the PKCE code challenge for the
+// "plain" method, which is just the verifier itself. Prefer
+// GenerateCodeChallenge ("S256") unless the authorization server doesn't
+// support it.
+func GenerateCodeChallengePlain(verifier string) string {
 	return verifier
-}
\ No newline at end of file
+}
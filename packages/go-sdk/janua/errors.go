@@ -4,8 +4,10 @@ package janua
 import (
 	"encoding/json"
 	"fmt"
+	"mime"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -20,6 +22,7 @@ const (
 	ErrCodeAccountLocked      = "ACCOUNT_LOCKED"
 	ErrCodeSessionExpired     = "SESSION_EXPIRED"
 	ErrCodeInvalidCredentials = "INVALID_CREDENTIALS" //nolint:gosec // G101: This is an error code constant, not a credential
+	ErrCodeTokenReused        = "TOKEN_REUSED"
 
 	// Authorization errors
 	ErrCodeAuthorization           = "AUTHORIZATION_ERROR"
@@ -81,6 +84,48 @@ func (e *JanuaError) Unwrap() error {
 	return e.Cause
 }
 
+// Is implements errors.Is support by matching on Code, so callers can write
+// errors.Is(err, janua.ErrMFARequired) regardless of the concrete error type
+// or how many times the error has been wrapped with fmt.Errorf("...: %w", err).
+func (e *JanuaError) Is(target error) bool {
+	t, ok := target.(*JanuaError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// Sentinel errors for the stable error-code taxonomy. Use these with
+// errors.Is, e.g. errors.Is(err, janua.ErrMFARequired), instead of the
+// IsXxx helpers or a type switch on the concrete error type.
+var (
+	ErrAuthentication          = &JanuaError{Code: ErrCodeAuthentication}
+	ErrTokenError              = &JanuaError{Code: ErrCodeTokenError}
+	ErrEmailNotVerified        = &JanuaError{Code: ErrCodeEmailNotVerified}
+	ErrMFARequired             = &JanuaError{Code: ErrCodeMFARequired}
+	ErrPasswordExpired         = &JanuaError{Code: ErrCodePasswordExpired}
+	ErrAccountLocked           = &JanuaError{Code: ErrCodeAccountLocked}
+	ErrSessionExpired          = &JanuaError{Code: ErrCodeSessionExpired}
+	ErrInvalidCredentials      = &JanuaError{Code: ErrCodeInvalidCredentials}
+	ErrAuthorization           = &JanuaError{Code: ErrCodeAuthorization}
+	ErrInsufficientPermissions = &JanuaError{Code: ErrCodeInsufficientPermissions}
+	ErrAccessDenied            = &JanuaError{Code: ErrCodeAccessDenied}
+	ErrValidation              = &JanuaError{Code: ErrCodeValidation}
+	ErrNotFound                = &JanuaError{Code: ErrCodeNotFound}
+	ErrConflict                = &JanuaError{Code: ErrCodeConflict}
+	ErrRateLimit               = &JanuaError{Code: ErrCodeRateLimit}
+	ErrInternal                = &JanuaError{Code: ErrCodeInternal}
+	ErrExternalService         = &JanuaError{Code: ErrCodeExternalService}
+	ErrServiceUnavailable      = &JanuaError{Code: ErrCodeServiceUnavailable}
+	ErrSSOAuthentication       = &JanuaError{Code: ErrCodeSSOAuthentication}
+	ErrSSOValidation           = &JanuaError{Code: ErrCodeSSOValidation}
+	ErrSSOConfiguration        = &JanuaError{Code: ErrCodeSSOConfiguration}
+	ErrSSOMetadata             = &JanuaError{Code: ErrCodeSSOMetadata}
+	ErrSSOCertificate          = &JanuaError{Code: ErrCodeSSOCertificate}
+	ErrSSOProvisioning         = &JanuaError{Code: ErrCodeSSOProvisioning}
+	ErrNetwork                 = &JanuaError{Code: "NETWORK_ERROR"}
+)
+
 // IsRetryable returns true if the error is retryable
 func (e *JanuaError) IsRetryable() bool {
 	switch e.StatusCode {
@@ -332,6 +377,29 @@ func NewNetworkError(cause error) *NetworkError {
 	}
 }
 
+// StreamError indicates a real-time event stream (SSE or long-poll), such
+// as SessionsService.Watch or WebhooksService.Watch, could not be
+// established or was permanently disrupted after exhausting reconnect
+// attempts.
+type StreamError struct {
+	JanuaError
+	// Attempts is the number of reconnect attempts made before giving up.
+	Attempts int
+}
+
+// NewStreamError creates a new stream error wrapping cause, the error from
+// the final reconnect attempt.
+func NewStreamError(message string, attempts int, cause error) *StreamError {
+	return &StreamError{
+		JanuaError: JanuaError{
+			Code:    "STREAM_ERROR",
+			Message: message,
+			Cause:   cause,
+		},
+		Attempts: attempts,
+	}
+}
+
 // InternalError represents server-side errors
 type InternalError struct {
 	JanuaError
@@ -349,6 +417,108 @@ func NewInternalError(requestID string) *InternalError {
 	}
 }
 
+// SSOCertificateError indicates a problem with an X.509 client certificate
+// presented for mTLS authentication, such as an expired or untrusted
+// certificate.
+type SSOCertificateError struct {
+	JanuaError
+	// NotBefore is the start of the certificate's validity window.
+	NotBefore *time.Time
+	// NotAfter is when the certificate expires.
+	NotAfter *time.Time
+	// Subject is the certificate's subject distinguished name.
+	Subject string
+	// Issuer is the certificate's issuer distinguished name.
+	Issuer string
+}
+
+// NewSSOCertificateError creates a new SSO certificate error
+func NewSSOCertificateError(message string, details map[string]interface{}) *SSOCertificateError {
+	return &SSOCertificateError{
+		JanuaError: JanuaError{
+			Code:       ErrCodeSSOCertificate,
+			Message:    message,
+			StatusCode: http.StatusUnauthorized,
+			Details:    details,
+		},
+	}
+}
+
+// TokenReuseError indicates the server detected reuse of a rotated refresh
+// token: the token presented was already exchanged once before. This is a
+// signal of possible token theft, and the server revokes the entire
+// RefreshTokenFamily it belongs to when it occurs.
+type TokenReuseError struct {
+	JanuaError
+	// FamilyID is the refresh token family that was revoked.
+	FamilyID string
+}
+
+// NewTokenReuseError creates a new token reuse error
+func NewTokenReuseError(familyID string) *TokenReuseError {
+	return &TokenReuseError{
+		JanuaError: JanuaError{
+			Code:       ErrCodeTokenReused,
+			Message:    "Refresh token reuse detected; session family revoked",
+			StatusCode: http.StatusUnauthorized,
+		},
+		FamilyID: familyID,
+	}
+}
+
+// problemDocument represents an RFC 7807 application/problem+json body.
+// Any members beyond the registered ones are treated as extensions and
+// merged into JanuaError.Details.
+type problemDocument struct {
+	Type     string                 `json:"type"`
+	Title    string                 `json:"title"`
+	Status   int                    `json:"status"`
+	Detail   string                 `json:"detail"`
+	Instance string                 `json:"instance"`
+	Code     string                 `json:"code"`
+	Extra    map[string]interface{} `json:"-"`
+}
+
+// isProblemJSON reports whether body looks like an RFC 7807 problem document,
+// either because the response declared application/problem+json or because
+// the body itself carries the problem+json member shape.
+func isProblemJSON(resp *http.Response, body []byte) bool {
+	if ct := resp.Header.Get("Content-Type"); ct != "" {
+		if mt, _, err := mime.ParseMediaType(ct); err == nil && mt == "application/problem+json" {
+			return true
+		}
+	}
+
+	var sniff map[string]interface{}
+	if err := parseJSON(body, &sniff); err != nil {
+		return false
+	}
+	_, hasType := sniff["type"]
+	_, hasTitle := sniff["title"]
+	_, hasErrorKey := sniff["error"]
+	return (hasType || hasTitle) && !hasErrorKey
+}
+
+// parseProblemJSON parses an RFC 7807 problem+json body, merging any
+// extension members into Details.
+func parseProblemJSON(body []byte) (problemDocument, map[string]interface{}, error) {
+	var doc problemDocument
+	if err := parseJSON(body, &doc); err != nil {
+		return doc, nil, err
+	}
+
+	var raw map[string]interface{}
+	if err := parseJSON(body, &raw); err != nil {
+		return doc, nil, err
+	}
+
+	for _, known := range []string{"type", "title", "status", "detail", "instance", "code"} {
+		delete(raw, known)
+	}
+
+	return doc, raw, nil
+}
+
 // ParseAPIError parses an HTTP response into the appropriate error type
 func ParseAPIError(resp *http.Response, body []byte) error {
 	// Try to parse as structured API error
@@ -367,23 +537,49 @@ func ParseAPIError(resp *http.Response, body []byte) error {
 		Message:    http.StatusText(resp.StatusCode),
 	}
 
-	// Try parsing as nested error format
-	if err := parseJSON(body, &apiErr); err == nil && apiErr.Error.Code != "" {
-		base.Code = apiErr.Error.Code
-		base.Message = apiErr.Error.Message
-		base.Details = apiErr.Error.Details
-		base.RequestID = apiErr.Error.RequestID
-	} else {
-		// Try flat format
-		var flatErr struct {
-			Code    string                 `json:"code"`
-			Message string                 `json:"message"`
-			Details map[string]interface{} `json:"details,omitempty"`
+	switch {
+	case isProblemJSON(resp, body):
+		doc, extensions, err := parseProblemJSON(body)
+		if err == nil {
+			base.Code = doc.Code
+			if base.Code == "" {
+				base.Code = problemCodeFromType(doc.Type, doc.Title)
+			}
+			base.Message = doc.Detail
+			if base.Message == "" {
+				base.Message = doc.Title
+			}
+			if doc.Status != 0 {
+				base.StatusCode = doc.Status
+			}
+			if doc.Instance != "" {
+				base.RequestID = doc.Instance
+			}
+			if len(extensions) > 0 {
+				base.Details = extensions
+			}
+			break
 		}
-		if err := parseJSON(body, &flatErr); err == nil && flatErr.Code != "" {
-			base.Code = flatErr.Code
-			base.Message = flatErr.Message
-			base.Details = flatErr.Details
+		fallthrough
+	default:
+		// Try parsing as nested error format
+		if err := parseJSON(body, &apiErr); err == nil && apiErr.Error.Code != "" {
+			base.Code = apiErr.Error.Code
+			base.Message = apiErr.Error.Message
+			base.Details = apiErr.Error.Details
+			base.RequestID = apiErr.Error.RequestID
+		} else {
+			// Try flat format
+			var flatErr struct {
+				Code    string                 `json:"code"`
+				Message string                 `json:"message"`
+				Details map[string]interface{} `json:"details,omitempty"`
+			}
+			if err := parseJSON(body, &flatErr); err == nil && flatErr.Code != "" {
+				base.Code = flatErr.Code
+				base.Message = flatErr.Message
+				base.Details = flatErr.Details
+			}
 		}
 	}
 
@@ -502,6 +698,35 @@ func ParseAPIError(resp *http.Response, body []byte) error {
 		return &RateLimitError{JanuaError: base}
 	case ErrCodeInternal, ErrCodeServiceUnavailable:
 		return &InternalError{JanuaError: base}
+	case ErrCodeSSOCertificate:
+		certErr := &SSOCertificateError{JanuaError: base}
+		if base.Details != nil {
+			if subject, ok := base.Details["subject"].(string); ok {
+				certErr.Subject = subject
+			}
+			if issuer, ok := base.Details["issuer"].(string); ok {
+				certErr.Issuer = issuer
+			}
+			if notBefore, ok := base.Details["not_before"].(string); ok {
+				if t, err := time.Parse(time.RFC3339, notBefore); err == nil {
+					certErr.NotBefore = &t
+				}
+			}
+			if notAfter, ok := base.Details["not_after"].(string); ok {
+				if t, err := time.Parse(time.RFC3339, notAfter); err == nil {
+					certErr.NotAfter = &t
+				}
+			}
+		}
+		return certErr
+	case ErrCodeTokenReused:
+		reuseErr := &TokenReuseError{JanuaError: base}
+		if base.Details != nil {
+			if familyID, ok := base.Details["family_id"].(string); ok {
+				reuseErr.FamilyID = familyID
+			}
+		}
+		return reuseErr
 	default:
 		return &base
 	}
@@ -577,49 +802,22 @@ func IsRetryable(err error) bool {
 	return false
 }
 
-// GetUserMessage returns a user-friendly error message
-func GetUserMessage(err error) string {
-	messages := map[string]string{
-		ErrCodeAuthentication:          "Invalid email or password. Please try again.",
-		ErrCodeTokenError:              "Your session is invalid. Please sign in again.",
-		ErrCodeEmailNotVerified:        "Please verify your email address to continue.",
-		ErrCodeMFARequired:             "Please complete two-factor authentication.",
-		ErrCodePasswordExpired:         "Your password has expired. Please reset it.",
-		ErrCodeAccountLocked:           "Your account is temporarily locked. Please try again later.",
-		ErrCodeSessionExpired:          "Your session has expired. Please sign in again.",
-		ErrCodeAuthorization:           "You don't have permission to perform this action.",
-		ErrCodeInsufficientPermissions: "You need additional permissions for this action.",
-		ErrCodeValidation:              "Please check your input and try again.",
-		ErrCodeNotFound:                "The requested resource was not found.",
-		ErrCodeConflict:                "This action conflicts with existing data.",
-		ErrCodeRateLimit:               "Too many requests. Please wait a moment and try again.",
-		ErrCodeInternal:                "An unexpected error occurred. Please try again later.",
-		"NETWORK_ERROR":                "Unable to connect. Please check your internet connection.",
+// problemCodeFromType derives a taxonomy error code from a problem+json
+// "type" URI (typically its final path segment) or, failing that, its
+// "title", so problem documents that don't set our "code" extension still
+// map onto the stable taxonomy rather than always falling back to
+// UNKNOWN_ERROR.
+func problemCodeFromType(problemType, title string) string {
+	candidate := problemType
+	if candidate == "" || candidate == "about:blank" {
+		candidate = title
+	} else if idx := strings.LastIndexByte(candidate, '/'); idx != -1 {
+		candidate = candidate[idx+1:]
 	}
-
-	if e, ok := err.(*JanuaError); ok {
-		if msg, exists := messages[e.Code]; exists {
-			return msg
-		}
-		return e.Message
-	}
-
-	// Check specific error types
-	switch e := err.(type) {
-	case *AuthenticationError:
-		return messages[ErrCodeAuthentication]
-	case *MFARequiredError:
-		return messages[ErrCodeMFARequired]
-	case *RateLimitError:
-		if e.RetryAfter > 0 {
-			return fmt.Sprintf("Too many requests. Please try again in %s.", e.RetryAfter)
-		}
-		return messages[ErrCodeRateLimit]
-	case *NetworkError:
-		return messages["NETWORK_ERROR"]
+	if candidate == "" {
+		return "UNKNOWN_ERROR"
 	}
-
-	return "An unexpected error occurred."
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(candidate), "-", "_"))
 }
 
 // parseJSON is a helper to parse JSON from bytes
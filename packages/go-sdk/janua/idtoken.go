@@ -0,0 +1,204 @@
+package janua
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// jwksRefreshRateLimit bounds how often IDTokenVerifier will force a JWKS
+// refetch in response to an unrecognized kid, so a flood of tokens signed
+// with a bogus kid can't be used to hammer the issuer's JWKS endpoint.
+const jwksRefreshRateLimit = 1 * time.Minute
+
+// IDTokenVerifierConfig configures an IDTokenVerifier.
+type IDTokenVerifierConfig struct {
+	// Client supplies the OIDC discovery document and JWKS (via
+	// Client.OIDC) to verify against.
+	Client *Client
+	// Audience is the expected "aud" claim (typically the relying
+	// party's client ID).
+	Audience string
+	// ClockSkew bounds how far exp/nbf/iat may drift from the local
+	// clock. Defaults to 1 minute.
+	ClockSkew time.Duration
+}
+
+// IDTokenVerifier validates OIDC ID tokens against the issuer's discovery
+// document and JWKS, supporting RS256/384/512, ES256/384/512, and EdDSA,
+// and refetching the JWKS (rate-limited) when a token names a kid that
+// isn't in the cached set.
+type IDTokenVerifier struct {
+	client    *Client
+	audience  string
+	clockSkew time.Duration
+
+	refreshMu     sync.Mutex
+	lastRefreshAt time.Time
+}
+
+// NewIDTokenVerifier creates an IDTokenVerifier from config.
+func NewIDTokenVerifier(config IDTokenVerifierConfig) *IDTokenVerifier {
+	clockSkew := config.ClockSkew
+	if clockSkew == 0 {
+		clockSkew = 1 * time.Minute
+	}
+	return &IDTokenVerifier{client: config.Client, audience: config.Audience, clockSkew: clockSkew}
+}
+
+// VerifyOptions customizes a single Verify call.
+type VerifyOptions struct {
+	// Nonce, if set, must match the token's "nonce" claim exactly -
+	// callers doing an authorization-code or implicit flow should pass
+	// the nonce they sent in the authorization request.
+	Nonce string
+}
+
+// Verify validates tokenString's signature, issuer, audience, and standard
+// time claims (exp/nbf/iat, within the configured clock skew), returning
+// its parsed Claims.
+func (v *IDTokenVerifier) Verify(ctx context.Context, tokenString string, opts *VerifyOptions) (*Claims, error) {
+	discovery, err := v.client.OIDC.Discovery(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch discovery document: %w", err)
+	}
+
+	var claims Claims
+	token, err := jwt.ParseWithClaims(tokenString, &claims, v.keyfunc(ctx),
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "EdDSA"}),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(v.audience),
+		jwt.WithLeeway(v.clockSkew),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("verify id token: %w", err)
+	}
+	if !token.Valid {
+		return nil, fmt.Errorf("invalid id token")
+	}
+
+	if opts != nil && opts.Nonce != "" && claims.Nonce != opts.Nonce {
+		return nil, fmt.Errorf("nonce mismatch")
+	}
+
+	return &claims, nil
+}
+
+// keyfunc resolves a token's "kid" header to a public key, refetching the
+// JWKS (rate-limited) if the kid isn't in the cached set.
+func (v *IDTokenVerifier) keyfunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		jwks, err := v.client.OIDC.JWKS(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		key := findJWK(jwks, kid)
+		if key == nil {
+			if jwks, err = v.rateLimitedRefresh(ctx); err != nil {
+				return nil, err
+			}
+			key = findJWK(jwks, kid)
+		}
+		if key == nil {
+			return nil, fmt.Errorf("no key found for kid %q", kid)
+		}
+
+		return publicKeyFromJWK(*key)
+	}
+}
+
+func (v *IDTokenVerifier) rateLimitedRefresh(ctx context.Context) (*JWKS, error) {
+	v.refreshMu.Lock()
+	defer v.refreshMu.Unlock()
+
+	if time.Since(v.lastRefreshAt) < jwksRefreshRateLimit {
+		// Too soon since the last forced refresh; serve the cached copy
+		// rather than refetch again.
+		return v.client.OIDC.JWKS(ctx)
+	}
+	v.lastRefreshAt = time.Now()
+	return v.client.OIDC.ForceRefreshJWKS(ctx)
+}
+
+func findJWK(jwks *JWKS, kid string) *JWK {
+	for i := range jwks.Keys {
+		if jwks.Keys[i].Kid == kid {
+			return &jwks.Keys[i]
+		}
+	}
+	return nil
+}
+
+// publicKeyFromJWK converts a JWK into the public key type golang-jwt
+// expects for the corresponding signing method family.
+func publicKeyFromJWK(key JWK) (interface{}, error) {
+	switch key.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return nil, fmt.Errorf("decode RSA modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return nil, fmt.Errorf("decode RSA exponent: %w", err)
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+
+	case "EC":
+		curve, err := ecCurve(key.Crv)
+		if err != nil {
+			return nil, err
+		}
+		x, err := base64.RawURLEncoding.DecodeString(key.X)
+		if err != nil {
+			return nil, fmt.Errorf("decode EC x: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(key.Y)
+		if err != nil {
+			return nil, fmt.Errorf("decode EC y: %w", err)
+		}
+		return &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+
+	case "OKP":
+		x, err := base64.RawURLEncoding.DecodeString(key.X)
+		if err != nil {
+			return nil, fmt.Errorf("decode OKP x: %w", err)
+		}
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", key.Kty)
+	}
+}
+
+func ecCurve(crv string) (elliptic.Curve, error) {
+	switch crv {
+	case "P-256":
+		return elliptic.P256(), nil
+	case "P-384":
+		return elliptic.P384(), nil
+	case "P-521":
+		return elliptic.P521(), nil
+	default:
+		return nil, fmt.Errorf("unsupported EC curve %q", crv)
+	}
+}
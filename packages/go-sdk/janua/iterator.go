@@ -0,0 +1,72 @@
+package janua
+
+import (
+	"context"
+	"io"
+)
+
+// Iterator walks every page of a Paginated[T] list endpoint, fetching
+// pages lazily as items are consumed rather than requiring callers to
+// reimplement the page loop and per_page math themselves.
+type Iterator[T any] struct {
+	fetch func(ctx context.Context, page int) (*Paginated[T], error)
+	page  int
+	items []T
+	idx   int
+	done  bool
+}
+
+// newIterator builds an Iterator that calls fetch for page 1, 2, 3, ...
+// until a page comes back empty or past TotalPages.
+func newIterator[T any](fetch func(ctx context.Context, page int) (*Paginated[T], error)) *Iterator[T] {
+	return &Iterator[T]{fetch: fetch, page: 1}
+}
+
+// Next returns the next item across all pages, fetching additional pages
+// as needed, and io.EOF once every page has been exhausted.
+func (it *Iterator[T]) Next(ctx context.Context) (T, error) {
+	var zero T
+	for it.idx >= len(it.items) {
+		if it.done {
+			return zero, io.EOF
+		}
+
+		page, err := it.fetch(ctx, it.page)
+		if err != nil {
+			return zero, err
+		}
+
+		it.items = page.Data
+		it.idx = 0
+		it.page++
+		if len(page.Data) == 0 || (page.TotalPages > 0 && it.page > page.TotalPages) {
+			it.done = true
+		}
+	}
+
+	item := it.items[it.idx]
+	it.idx++
+	return item, nil
+}
+
+// All streams every item across all pages on a channel, closing it once
+// iteration ends or ctx is canceled. A fetch error silently ends the
+// stream; use Next directly if you need to observe it.
+func (it *Iterator[T]) All(ctx context.Context) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for {
+			item, err := it.Next(ctx)
+			if err != nil {
+				return
+			}
+			select {
+			case ch <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,191 @@
+// Package janua provides localized user-facing error messages for the
+// Janua SDK
+package janua
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// Message keys for strings that aren't a 1:1 mapping of a JanuaError.Code,
+// such as templated or state-dependent messages.
+const (
+	msgRateLimitRetry        = "rate_limit_retry"
+	msgSSOCertificateExpired = "sso_certificate_expired"
+	msgSSOCertificateNotYet  = "sso_certificate_not_yet_valid"
+	msgUnexpected            = "unexpected_error"
+)
+
+var (
+	messagesMu  sync.RWMutex
+	messageCat  = catalog.NewBuilder(catalog.Fallback(language.English))
+	registered  = map[language.Tag]map[string]bool{}
+	defaultLang = language.English
+)
+
+func init() {
+	RegisterMessages(language.English, map[string]string{
+		ErrCodeAuthentication:          "Invalid email or password. Please try again.",
+		ErrCodeTokenError:              "Your session is invalid. Please sign in again.",
+		ErrCodeEmailNotVerified:        "Please verify your email address to continue.",
+		ErrCodeMFARequired:             "Please complete two-factor authentication.",
+		ErrCodePasswordExpired:         "Your password has expired. Please reset it.",
+		ErrCodeAccountLocked:           "Your account is temporarily locked. Please try again later.",
+		ErrCodeSessionExpired:          "Your session has expired. Please sign in again.",
+		ErrCodeAuthorization:           "You don't have permission to perform this action.",
+		ErrCodeInsufficientPermissions: "You need additional permissions for this action.",
+		ErrCodeValidation:              "Please check your input and try again.",
+		ErrCodeNotFound:                "The requested resource was not found.",
+		ErrCodeConflict:                "This action conflicts with existing data.",
+		ErrCodeRateLimit:               "Too many requests. Please wait a moment and try again.",
+		ErrCodeInternal:                "An unexpected error occurred. Please try again later.",
+		ErrCodeSSOCertificate:          "Your client certificate could not be verified. Please check that it is valid and trusted.",
+		"NETWORK_ERROR":                "Unable to connect. Please check your internet connection.",
+		msgSSOCertificateExpired:       "Your client certificate has expired. Please request a new one.",
+		msgSSOCertificateNotYet:        "Your client certificate is not yet valid.",
+		msgUnexpected:                  "An unexpected error occurred.",
+	})
+	registerRateLimitRetry(language.English,
+		plural.Selectf(1, "%d",
+			plural.One, "Too many requests. Please try again in 1 second.",
+			plural.Other, "Too many requests. Please try again in %[1]d seconds."))
+
+	RegisterMessages(language.Spanish, map[string]string{
+		ErrCodeAuthentication:          "Correo electrónico o contraseña no válidos. Inténtalo de nuevo.",
+		ErrCodeTokenError:              "Tu sesión no es válida. Vuelve a iniciar sesión.",
+		ErrCodeEmailNotVerified:        "Verifica tu dirección de correo electrónico para continuar.",
+		ErrCodeMFARequired:             "Completa la autenticación de dos factores.",
+		ErrCodePasswordExpired:         "Tu contraseña ha caducado. Restablécela.",
+		ErrCodeAccountLocked:           "Tu cuenta está bloqueada temporalmente. Inténtalo de nuevo más tarde.",
+		ErrCodeSessionExpired:          "Tu sesión ha caducado. Vuelve a iniciar sesión.",
+		ErrCodeAuthorization:           "No tienes permiso para realizar esta acción.",
+		ErrCodeInsufficientPermissions: "Necesitas permisos adicionales para esta acción.",
+		ErrCodeValidation:              "Revisa tu información e inténtalo de nuevo.",
+		ErrCodeNotFound:                "No se encontró el recurso solicitado.",
+		ErrCodeConflict:                "Esta acción entra en conflicto con datos existentes.",
+		ErrCodeRateLimit:               "Demasiadas solicitudes. Espera un momento e inténtalo de nuevo.",
+		ErrCodeInternal:                "Ocurrió un error inesperado. Inténtalo de nuevo más tarde.",
+		ErrCodeSSOCertificate:          "No se pudo verificar tu certificado de cliente. Comprueba que sea válido y de confianza.",
+		"NETWORK_ERROR":                "No se pudo conectar. Comprueba tu conexión a Internet.",
+		msgSSOCertificateExpired:       "Tu certificado de cliente ha caducado. Solicita uno nuevo.",
+		msgSSOCertificateNotYet:        "Tu certificado de cliente aún no es válido.",
+		msgUnexpected:                  "Ocurrió un error inesperado.",
+	})
+	registerRateLimitRetry(language.Spanish,
+		plural.Selectf(1, "%d",
+			plural.One, "Demasiadas solicitudes. Inténtalo de nuevo en 1 segundo.",
+			plural.Other, "Demasiadas solicitudes. Inténtalo de nuevo en %[1]d segundos."))
+}
+
+// SetDefaultLanguage sets the language GetUserMessage uses when no explicit
+// language.Tag is given. It defaults to language.English.
+func SetDefaultLanguage(tag language.Tag) {
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+	defaultLang = tag
+}
+
+// RegisterMessages registers or overrides the user-facing messages for the
+// given language, keyed by JanuaError.Code (or one of the msgXxx keys for
+// templated messages). Call at init time to add additional languages or
+// override the SDK's built-in translations without forking the SDK.
+func RegisterMessages(tag language.Tag, msgs map[string]string) {
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+	if registered[tag] == nil {
+		registered[tag] = map[string]bool{}
+	}
+	for key, msg := range msgs {
+		// SetString only fails if key is already bound to a non-String
+		// Message (e.g. a plural.Selectf), which none of our keys are.
+		_ = messageCat.SetString(tag, key, msg)
+		registered[tag][key] = true
+	}
+}
+
+// registerRateLimitRetry registers the plural-aware "try again in N
+// seconds" message for tag under msgRateLimitRetry. It is kept separate
+// from RegisterMessages because it needs catalog.Message values (for
+// plural selection) rather than a plain string.
+func registerRateLimitRetry(tag language.Tag, msg catalog.Message) {
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+	_ = messageCat.Set(tag, msgRateLimitRetry, msg)
+	if registered[tag] == nil {
+		registered[tag] = map[string]bool{}
+	}
+	registered[tag][msgRateLimitRetry] = true
+}
+
+// isRegistered reports whether key has a translation for tag, falling back
+// to the default language so overriding a handful of keys doesn't require
+// registering every key for every language.
+func isRegistered(tag language.Tag, key string) bool {
+	messagesMu.RLock()
+	defer messagesMu.RUnlock()
+	if registered[tag][key] {
+		return true
+	}
+	return registered[language.English][key]
+}
+
+// GetUserMessage returns a user-friendly error message in the default
+// language (English unless changed via SetDefaultLanguage).
+func GetUserMessage(err error) string {
+	messagesMu.RLock()
+	tag := defaultLang
+	messagesMu.RUnlock()
+	return GetUserMessageLocalized(err, tag)
+}
+
+// GetUserMessageLocalized returns a user-friendly error message for err,
+// translated into the language identified by tag via the package's message
+// catalog. Unregistered codes fall back to the error's own Message.
+func GetUserMessageLocalized(err error, tag language.Tag) string {
+	p := message.NewPrinter(tag, message.Catalog(messageCat))
+
+	switch e := err.(type) {
+	case *RateLimitError:
+		if e.RetryAfter > 0 {
+			return p.Sprintf(msgRateLimitRetry, int(e.RetryAfter.Round(time.Second)/time.Second))
+		}
+		return localizedOrFallback(p, tag, ErrCodeRateLimit, e.Message)
+	case *SSOCertificateError:
+		switch {
+		case e.NotAfter != nil && time.Now().After(*e.NotAfter):
+			return localizedOrFallback(p, tag, msgSSOCertificateExpired, e.Message)
+		case e.NotBefore != nil && time.Now().Before(*e.NotBefore):
+			return localizedOrFallback(p, tag, msgSSOCertificateNotYet, e.Message)
+		default:
+			return localizedOrFallback(p, tag, ErrCodeSSOCertificate, e.Message)
+		}
+	case *JanuaError:
+		return localizedOrFallback(p, tag, e.Code, e.Message)
+	case *AuthenticationError:
+		return localizedOrFallback(p, tag, ErrCodeAuthentication, e.Message)
+	case *MFARequiredError:
+		return localizedOrFallback(p, tag, ErrCodeMFARequired, e.Message)
+	case *NetworkError:
+		return localizedOrFallback(p, tag, "NETWORK_ERROR", e.Message)
+	}
+
+	return p.Sprintf(msgUnexpected)
+}
+
+// localizedOrFallback returns the catalog translation for key in tag's
+// language if one is registered, or fallback (typically the error's own
+// Message) otherwise.
+func localizedOrFallback(p *message.Printer, tag language.Tag, key, fallback string) string {
+	if !isRegistered(tag, key) {
+		if fallback != "" {
+			return fallback
+		}
+		return p.Sprintf(msgUnexpected)
+	}
+	return p.Sprintf(key)
+}
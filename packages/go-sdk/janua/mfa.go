@@ -0,0 +1,151 @@
+// Package janua provides WebAuthn/passkey multi-factor authentication
+// support for the Janua SDK
+package janua
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// MFA method constants identify the factor used to complete an
+// MFARequiredError challenge. Use these instead of raw strings to avoid
+// typos when inspecting MFARequiredError.AvailableMethods or building an
+// MFAVerifyRequest.
+const (
+	MFAMethodTOTP         = "totp"
+	MFAMethodSMS          = "sms"
+	MFAMethodWebAuthn     = "webauthn"
+	MFAMethodRecoveryCode = "recovery_code"
+)
+
+// WebAuthnChallenge is the server-issued challenge for a WebAuthn/passkey
+// MFA step, shaped to drive navigator.credentials.get() in the browser
+// without the caller needing to know the wire format of Details["webauthn"].
+type WebAuthnChallenge struct {
+	// RPID is the WebAuthn relying party ID.
+	RPID string
+	// Challenge is the base64url-encoded challenge bytes to sign.
+	Challenge string
+	// AllowCredentials lists the base64url-encoded credential IDs the
+	// authenticator may use to satisfy the challenge.
+	AllowCredentials []string
+	// UserVerification is the requested user verification requirement
+	// ("required", "preferred", or "discouraged").
+	UserVerification string
+	// Timeout bounds how long the authenticator has to respond.
+	Timeout time.Duration
+}
+
+// WebAuthnChallenge decodes the WebAuthn challenge carried in Details when
+// "webauthn" is among AvailableMethods, so SDK consumers don't have to
+// hand-parse Details themselves. It returns nil if this MFA requirement
+// doesn't offer WebAuthn.
+func (e *MFARequiredError) WebAuthnChallenge() *WebAuthnChallenge {
+	hasWebAuthn := false
+	for _, m := range e.AvailableMethods {
+		if m == MFAMethodWebAuthn {
+			hasWebAuthn = true
+			break
+		}
+	}
+	if !hasWebAuthn {
+		return nil
+	}
+
+	raw, ok := e.Details["webauthn"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	challenge := &WebAuthnChallenge{}
+	if rpID, ok := raw["rp_id"].(string); ok {
+		challenge.RPID = rpID
+	}
+	if c, ok := raw["challenge"].(string); ok {
+		challenge.Challenge = c
+	}
+	if creds, ok := raw["allow_credentials"].([]interface{}); ok {
+		for _, cr := range creds {
+			if s, ok := cr.(string); ok {
+				challenge.AllowCredentials = append(challenge.AllowCredentials, s)
+			}
+		}
+	}
+	if uv, ok := raw["user_verification"].(string); ok {
+		challenge.UserVerification = uv
+	}
+	if timeoutMS, ok := raw["timeout"].(float64); ok {
+		challenge.Timeout = time.Duration(timeoutMS) * time.Millisecond
+	}
+
+	return challenge
+}
+
+// WebAuthnAssertion is the browser's navigator.credentials.get() result,
+// base64url-encoded per the WebAuthn spec, ready to submit to
+// MFAService.VerifyWebAuthn.
+type WebAuthnAssertion struct {
+	RawID             string `json:"raw_id"`
+	ClientDataJSON    string `json:"client_data_json"`
+	AuthenticatorData string `json:"authenticator_data"`
+	Signature         string `json:"signature"`
+	UserHandle        string `json:"user_handle,omitempty"`
+}
+
+// MFAService handles WebAuthn/passkey MFA enrollment and verification.
+type MFAService struct {
+	client *Client
+}
+
+// VerifyWebAuthn completes a pending MFA challenge with a WebAuthn
+// assertion, exchanging mfaToken for an authenticated Session.
+func (s *MFAService) VerifyWebAuthn(ctx context.Context, mfaToken string, assertion WebAuthnAssertion) (*Session, error) {
+	req := struct {
+		MFAToken  string            `json:"mfa_token"`
+		Assertion WebAuthnAssertion `json:"assertion"`
+	}{
+		MFAToken:  mfaToken,
+		Assertion: assertion,
+	}
+
+	resp, err := s.client.request(ctx, http.MethodPost, "/api/v1/auth/mfa/webauthn/verify", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var session Session
+	if err := decodeResponse(resp, &session); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// StartWebAuthnEnrollment begins WebAuthn/passkey MFA enrollment for the
+// current user, returning the registration options to pass to
+// navigator.credentials.create().
+func (s *MFAService) StartWebAuthnEnrollment(ctx context.Context) (map[string]interface{}, error) {
+	resp, err := s.client.request(ctx, http.MethodPost, "/api/v1/auth/mfa/webauthn/register/start", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var options map[string]interface{}
+	if err := decodeResponse(resp, &options); err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+// CompleteWebAuthnEnrollment finishes WebAuthn/passkey MFA enrollment using
+// the credential returned by navigator.credentials.create().
+func (s *MFAService) CompleteWebAuthnEnrollment(ctx context.Context, credential map[string]interface{}) error {
+	resp, err := s.client.request(ctx, http.MethodPost, "/api/v1/auth/mfa/webauthn/register/complete", credential)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(resp, nil)
+}
@@ -0,0 +1,65 @@
+// Package janua provides mTLS client-certificate authentication for the
+// Janua SDK
+package janua
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+)
+
+// ClientOption configures optional Client behavior that doesn't belong on
+// the base Config, such as mTLS client-certificate authentication for
+// bouncer/agent-style service accounts.
+type ClientOption func(*clientOptions) error
+
+// clientOptions accumulates the effect of ClientOptions before NewClient
+// builds the underlying http.Client.
+type clientOptions struct {
+	tlsConfig *tls.Config
+}
+
+func (o *clientOptions) ensureTLSConfig() *tls.Config {
+	if o.tlsConfig == nil {
+		o.tlsConfig = &tls.Config{}
+	}
+	return o.tlsConfig
+}
+
+// WithClientCertificate authenticates the SDK to Janua using an in-memory
+// PEM-encoded X.509 client certificate and private key instead of (or in
+// addition to) a bearer token.
+func WithClientCertificate(certPEM, keyPEM []byte) ClientOption {
+	return func(o *clientOptions) error {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("janua: parse client certificate: %w", err)
+		}
+		tlsConfig := o.ensureTLSConfig()
+		tlsConfig.Certificates = append(tlsConfig.Certificates, cert)
+		return nil
+	}
+}
+
+// WithClientCertificateFile is like WithClientCertificate but loads the
+// certificate and key from PEM files on disk.
+func WithClientCertificateFile(certPath, keyPath string) ClientOption {
+	return func(o *clientOptions) error {
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return fmt.Errorf("janua: load client certificate: %w", err)
+		}
+		tlsConfig := o.ensureTLSConfig()
+		tlsConfig.Certificates = append(tlsConfig.Certificates, cert)
+		return nil
+	}
+}
+
+// WithRootCAs sets the pool of root CAs the client trusts when verifying
+// the Janua API's server certificate, overriding the system pool.
+func WithRootCAs(pool *x509.CertPool) ClientOption {
+	return func(o *clientOptions) error {
+		o.ensureTLSConfig().RootCAs = pool
+		return nil
+	}
+}
@@ -0,0 +1,191 @@
+package janua
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// OIDCService fetches and caches the OIDC discovery document and JSON Web
+// Key Set the Janua API publishes for ID token verification.
+type OIDCService struct {
+	client *Client
+
+	mu          sync.Mutex
+	discovery   *OIDCDiscovery
+	jwks        *JWKS
+	jwksFetched time.Time
+}
+
+// jwksCacheTTL bounds how long a fetched JWKS is reused before refetching,
+// so a rotated signing key is picked up within a bounded window without
+// requiring a refetch on every verification.
+const jwksCacheTTL = 10 * time.Minute
+
+// OIDCDiscovery is the subset of the OIDC discovery document Janua
+// publishes that this SDK needs.
+type OIDCDiscovery struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	UserinfoEndpoint      string   `json:"userinfo_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	ScopesSupported       []string `json:"scopes_supported,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK is a single JSON Web Key. Which fields matter depends on Kty: RSA
+// uses N/E; EC uses Crv/X/Y; OKP (Ed25519) uses Crv/X.
+type JWK struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg,omitempty"`
+	Use string `json:"use,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// Discovery fetches and caches the OIDC discovery document for the lifetime
+// of the client.
+func (s *OIDCService) Discovery(ctx context.Context) (*OIDCDiscovery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.discovery != nil {
+		return s.discovery, nil
+	}
+
+	resp, err := s.client.request(ctx, http.MethodGet, "/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc OIDCDiscovery
+	if err := decodeResponse(resp, &doc); err != nil {
+		return nil, err
+	}
+
+	s.discovery = &doc
+	return s.discovery, nil
+}
+
+// JWKS fetches the signing key set from the discovery document's JWKSURI,
+// reusing the last fetch until it's older than jwksCacheTTL.
+func (s *OIDCService) JWKS(ctx context.Context) (*JWKS, error) {
+	s.mu.Lock()
+	if s.jwks != nil && time.Since(s.jwksFetched) < jwksCacheTTL {
+		defer s.mu.Unlock()
+		return s.jwks, nil
+	}
+	s.mu.Unlock()
+
+	discovery, err := s.Discovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jwksPath := discovery.JWKSURI
+	if u, err := url.Parse(discovery.JWKSURI); err == nil && u.Path != "" {
+		jwksPath = u.Path
+	}
+
+	resp, err := s.client.request(ctx, http.MethodGet, jwksPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys JWKS
+	if err := decodeResponse(resp, &keys); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.jwks = &keys
+	s.jwksFetched = time.Now()
+	s.mu.Unlock()
+
+	return &keys, nil
+}
+
+// ForceRefreshJWKS refetches the JWKS immediately, ignoring jwksCacheTTL.
+// IDTokenVerifier uses this (rate-limited) when a token's kid isn't in the
+// cached set, which can happen right after the issuer rotates its signing
+// key.
+func (s *OIDCService) ForceRefreshJWKS(ctx context.Context) (*JWKS, error) {
+	s.mu.Lock()
+	s.jwks = nil
+	s.mu.Unlock()
+	return s.JWKS(ctx)
+}
+
+// UserInfoClaims is the decoded response of the OIDC userinfo_endpoint.
+// Claim sets vary by issuer beyond the handful the spec fixes (sub, name,
+// email, ...), so this is a flexible map with typed getters rather than a
+// fixed struct.
+type UserInfoClaims map[string]interface{}
+
+// GetString returns the named claim as a string, and whether it was
+// present and of that type.
+func (c UserInfoClaims) GetString(key string) (string, bool) {
+	v, ok := c[key].(string)
+	return v, ok
+}
+
+// GetBool returns the named claim as a bool, and whether it was present
+// and of that type.
+func (c UserInfoClaims) GetBool(key string) (bool, bool) {
+	v, ok := c[key].(bool)
+	return v, ok
+}
+
+// GetTime returns the named claim as a time.Time, and whether it was
+// present and parseable. It accepts either a Unix timestamp (the common
+// case for OIDC time claims) or an RFC 3339 string.
+func (c UserInfoClaims) GetTime(key string) (time.Time, bool) {
+	switch v := c[key].(type) {
+	case float64:
+		return time.Unix(int64(v), 0), true
+	case string:
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// UserInfo fetches claims about the signed-in user from the OIDC
+// userinfo_endpoint, authenticated with the client's current access token.
+func (c *Client) UserInfo(ctx context.Context) (UserInfoClaims, error) {
+	discovery, err := c.OIDC.Discovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path := discovery.UserinfoEndpoint
+	if u, err := url.Parse(discovery.UserinfoEndpoint); err == nil && u.Path != "" {
+		path = u.Path
+	}
+
+	resp, err := c.request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims UserInfoClaims
+	if err := decodeResponse(resp, &claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
@@ -68,13 +68,7 @@ func (s *OrganizationsService) GetOrganizationBySlug(ctx context.Context, slug s
 
 // ListOrganizations lists all organizations
 func (s *OrganizationsService) ListOrganizations(ctx context.Context, opts *ListOptions) (*Paginated[Organization], error) {
-	path := "/api/v1/organizations"
-	if opts != nil {
-		path = fmt.Sprintf("%s?page=%d&per_page=%d", path, opts.Page, opts.PerPage)
-		if opts.Search != "" {
-			path = fmt.Sprintf("%s&search=%s", path, opts.Search)
-		}
-	}
+	path := appendQuery("/api/v1/organizations", opts.values())
 
 	resp, err := s.client.request(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -89,6 +83,21 @@ func (s *OrganizationsService) ListOrganizations(ctx context.Context, opts *List
 	return &result, nil
 }
 
+// ListAllOrganizations returns an Iterator over every organization
+// matching opts, walking all pages as it's consumed so callers don't have
+// to loop over ListOrganizations themselves.
+func (s *OrganizationsService) ListAllOrganizations(opts *ListOptions) *Iterator[Organization] {
+	base := ListOptions{}
+	if opts != nil {
+		base = *opts
+	}
+	return newIterator(func(ctx context.Context, page int) (*Paginated[Organization], error) {
+		pageOpts := base
+		pageOpts.Page = page
+		return s.ListOrganizations(ctx, &pageOpts)
+	})
+}
+
 // UpdateOrganizationRequest represents an organization update request
 type UpdateOrganizationRequest struct {
 	Name        string                 `json:"name,omitempty"`
@@ -137,10 +146,7 @@ type OrganizationMember struct {
 
 // GetOrganizationMembers gets organization members
 func (s *OrganizationsService) GetOrganizationMembers(ctx context.Context, orgID string, opts *ListOptions) (*Paginated[OrganizationMember], error) {
-	path := fmt.Sprintf("/api/v1/organizations/%s/members", orgID)
-	if opts != nil {
-		path = fmt.Sprintf("%s?page=%d&per_page=%d", path, opts.Page, opts.PerPage)
-	}
+	path := appendQuery(fmt.Sprintf("/api/v1/organizations/%s/members", orgID), opts.values())
 
 	resp, err := s.client.request(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -155,6 +161,21 @@ func (s *OrganizationsService) GetOrganizationMembers(ctx context.Context, orgID
 	return &result, nil
 }
 
+// GetAllOrganizationMembers returns an Iterator over every member of
+// orgID matching opts, walking all pages as it's consumed so callers
+// don't have to loop over GetOrganizationMembers themselves.
+func (s *OrganizationsService) GetAllOrganizationMembers(orgID string, opts *ListOptions) *Iterator[OrganizationMember] {
+	base := ListOptions{}
+	if opts != nil {
+		base = *opts
+	}
+	return newIterator(func(ctx context.Context, page int) (*Paginated[OrganizationMember], error) {
+		pageOpts := base
+		pageOpts.Page = page
+		return s.GetOrganizationMembers(ctx, orgID, &pageOpts)
+	})
+}
+
 // AddOrganizationMemberRequest represents a member addition request
 type AddOrganizationMemberRequest struct {
 	UserID string   `json:"user_id,omitempty"`
@@ -259,6 +280,26 @@ func (s *OrganizationsService) ListOrganizationInvites(ctx context.Context, orgI
 	return invites, nil
 }
 
+// ListAllOrganizationInvites returns an Iterator over orgID's invites.
+// The invites endpoint doesn't paginate server-side, so this fetches the
+// full list once; it exists for API symmetry with the other ListAll*
+// helpers and so callers can switch to real pagination later without
+// changing how they consume it.
+func (s *OrganizationsService) ListAllOrganizationInvites(orgID string) *Iterator[OrganizationInvite] {
+	fetched := false
+	return newIterator(func(ctx context.Context, page int) (*Paginated[OrganizationInvite], error) {
+		if fetched {
+			return &Paginated[OrganizationInvite]{}, nil
+		}
+		invites, err := s.ListOrganizationInvites(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		fetched = true
+		return &Paginated[OrganizationInvite]{Data: invites, Page: 1, TotalPages: 1}, nil
+	})
+}
+
 // RevokeOrganizationInvite revokes an organization invite
 func (s *OrganizationsService) RevokeOrganizationInvite(ctx context.Context, orgID, inviteID string) error {
 	resp, err := s.client.request(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/organizations/%s/invites/%s", orgID, inviteID), nil)
@@ -0,0 +1,149 @@
+package janua
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// CloneOrganizationRole copies roleID from srcOrgID into dstOrgID. The
+// clone starts from the source role's name, description, and permissions;
+// any non-zero field on overrides replaces the corresponding field before
+// the role is created, so a caller can e.g. rename the role without
+// hand-stitching a GetOrganizationRoles + CreateOrganizationRole call.
+func (s *OrganizationsService) CloneOrganizationRole(ctx context.Context, srcOrgID, roleID, dstOrgID string, overrides *CreateOrganizationRoleRequest) (*OrganizationRole, error) {
+	roles, err := s.GetOrganizationRoles(ctx, srcOrgID)
+	if err != nil {
+		return nil, fmt.Errorf("get source roles: %w", err)
+	}
+
+	var src *OrganizationRole
+	for i := range roles {
+		if roles[i].ID == roleID {
+			src = &roles[i]
+			break
+		}
+	}
+	if src == nil {
+		return nil, fmt.Errorf("role %q not found in organization %q", roleID, srcOrgID)
+	}
+
+	req := CreateOrganizationRoleRequest{
+		Name:        src.Name,
+		Description: src.Description,
+		Permissions: src.Permissions,
+	}
+	if overrides != nil {
+		if overrides.Name != "" {
+			req.Name = overrides.Name
+		}
+		if overrides.Description != "" {
+			req.Description = overrides.Description
+		}
+		if overrides.Permissions != nil {
+			req.Permissions = overrides.Permissions
+		}
+	}
+
+	return s.CreateOrganizationRole(ctx, dstOrgID, &req)
+}
+
+// RolePermissionDiff is one role, present in both organizations compared
+// by DiffOrganizationRoles, whose permission sets differ.
+type RolePermissionDiff struct {
+	Name               string
+	AddedPermissions   []string
+	RemovedPermissions []string
+}
+
+// RoleDiff is the result of comparing two organizations' roles, matched by
+// name since role IDs aren't shared across organizations.
+type RoleDiff struct {
+	// AddedInB holds roles present in orgB but not orgA.
+	AddedInB []OrganizationRole
+	// RemovedInB holds roles present in orgA but not orgB.
+	RemovedInB []OrganizationRole
+	// Changed holds roles present in both whose permission sets differ.
+	Changed []RolePermissionDiff
+}
+
+// DiffOrganizationRoles compares orgA's and orgB's roles, matched by name,
+// and reports roles added or removed in orgB relative to orgA along with
+// per-role permission set differences. It's meant for multi-tenant
+// provisioning: seeding a new org's RBAC from a template org, or auditing
+// drift between them, pairs naturally with SyncOrganizationMembers for
+// GitOps-style role management.
+func (s *OrganizationsService) DiffOrganizationRoles(ctx context.Context, orgA, orgB string) (*RoleDiff, error) {
+	rolesA, err := s.GetOrganizationRoles(ctx, orgA)
+	if err != nil {
+		return nil, fmt.Errorf("get roles for %q: %w", orgA, err)
+	}
+	rolesB, err := s.GetOrganizationRoles(ctx, orgB)
+	if err != nil {
+		return nil, fmt.Errorf("get roles for %q: %w", orgB, err)
+	}
+
+	byNameA := make(map[string]OrganizationRole, len(rolesA))
+	for _, r := range rolesA {
+		byNameA[r.Name] = r
+	}
+	byNameB := make(map[string]OrganizationRole, len(rolesB))
+	for _, r := range rolesB {
+		byNameB[r.Name] = r
+	}
+
+	diff := &RoleDiff{}
+
+	for _, b := range rolesB {
+		a, exists := byNameA[b.Name]
+		if !exists {
+			diff.AddedInB = append(diff.AddedInB, b)
+			continue
+		}
+
+		added, removed := diffPermissions(a.Permissions, b.Permissions)
+		if len(added) > 0 || len(removed) > 0 {
+			diff.Changed = append(diff.Changed, RolePermissionDiff{
+				Name:               b.Name,
+				AddedPermissions:   added,
+				RemovedPermissions: removed,
+			})
+		}
+	}
+
+	for _, a := range rolesA {
+		if _, exists := byNameB[a.Name]; !exists {
+			diff.RemovedInB = append(diff.RemovedInB, a)
+		}
+	}
+
+	return diff, nil
+}
+
+// diffPermissions returns the permissions added and removed going from a
+// to b, each sorted for deterministic output.
+func diffPermissions(a, b []string) (added, removed []string) {
+	inA := make(map[string]bool, len(a))
+	for _, p := range a {
+		inA[p] = true
+	}
+	inB := make(map[string]bool, len(b))
+	for _, p := range b {
+		inB[p] = true
+	}
+
+	for p := range inB {
+		if !inA[p] {
+			added = append(added, p)
+		}
+	}
+	for p := range inA {
+		if !inB[p] {
+			removed = append(removed, p)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
@@ -0,0 +1,151 @@
+package janua
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+)
+
+// SyncOptions configures SyncOrganizationMembers.
+type SyncOptions struct {
+	// DryRun computes the SyncReport without calling
+	// Add/Update/RemoveOrganizationMember.
+	DryRun bool
+	// RemoveExtra deletes members present on the server but absent from
+	// desired. Off by default, so a caller passing a partial desired set
+	// by mistake can't accidentally empty out the organization.
+	RemoveExtra bool
+	// Concurrency bounds how many Add/Update/Remove calls run at once.
+	// Defaults to 4.
+	Concurrency int
+}
+
+// SyncReport summarizes the reconciliation SyncOrganizationMembers
+// performed (or, in dry-run mode, would perform).
+type SyncReport struct {
+	Added   []OrganizationMember
+	Updated []OrganizationMember
+	Removed []OrganizationMember
+	Skipped []OrganizationMember
+	// Errors holds one error per failed item, keyed by user ID, so a
+	// single failure doesn't abort the rest of the batch.
+	Errors map[string]error
+}
+
+// SyncOrganizationMembers reconciles orgID's membership against desired:
+// members in desired but missing on the server are added, members whose
+// role or roles differ are updated, members already matching are
+// skipped, and (if opts.RemoveExtra) members on the server but absent
+// from desired are removed. Add/Update/Remove calls run concurrently, up
+// to opts.Concurrency at a time, and a failed item is recorded on
+// SyncReport.Errors rather than aborting the rest of the batch.
+func (s *OrganizationsService) SyncOrganizationMembers(ctx context.Context, orgID string, desired []OrganizationMember, opts SyncOptions) (*SyncReport, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	current := make(map[string]OrganizationMember)
+	it := s.GetAllOrganizationMembers(orgID, nil)
+	for {
+		member, err := it.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list current members: %w", err)
+		}
+		current[member.UserID] = member
+	}
+
+	desiredByUser := make(map[string]OrganizationMember, len(desired))
+	for _, m := range desired {
+		desiredByUser[m.UserID] = m
+	}
+
+	report := &SyncReport{Errors: make(map[string]error)}
+	var reportMu sync.Mutex
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	// run executes fn (unless opts.DryRun) and records its result on
+	// report under reportMu, bounded to concurrency in-flight calls.
+	run := func(userID string, dryRunResult OrganizationMember, fn func() (*OrganizationMember, error), record func(*SyncReport, OrganizationMember)) {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if opts.DryRun {
+				reportMu.Lock()
+				record(report, dryRunResult)
+				reportMu.Unlock()
+				return
+			}
+
+			member, err := fn()
+			reportMu.Lock()
+			defer reportMu.Unlock()
+			if err != nil {
+				report.Errors[userID] = err
+				return
+			}
+			record(report, *member)
+		}()
+	}
+
+	for userID, want := range desiredByUser {
+		want := want
+		have, exists := current[userID]
+
+		switch {
+		case !exists:
+			var email string
+			if want.User != nil {
+				email = want.User.Email
+			}
+			run(userID, want, func() (*OrganizationMember, error) {
+				return s.AddOrganizationMember(ctx, orgID, &AddOrganizationMemberRequest{
+					UserID: want.UserID,
+					Email:  email,
+					Role:   want.Role,
+					Roles:  want.Roles,
+				})
+			}, func(r *SyncReport, m OrganizationMember) { r.Added = append(r.Added, m) })
+
+		case have.Role != want.Role || !slices.Equal(have.Roles, want.Roles):
+			run(userID, want, func() (*OrganizationMember, error) {
+				return s.UpdateOrganizationMember(ctx, orgID, userID, &UpdateOrganizationMemberRequest{
+					Role:  want.Role,
+					Roles: want.Roles,
+				})
+			}, func(r *SyncReport, m OrganizationMember) { r.Updated = append(r.Updated, m) })
+
+		default:
+			reportMu.Lock()
+			report.Skipped = append(report.Skipped, have)
+			reportMu.Unlock()
+		}
+	}
+
+	if opts.RemoveExtra {
+		for userID, have := range current {
+			if _, wanted := desiredByUser[userID]; wanted {
+				continue
+			}
+			have := have
+			run(userID, have, func() (*OrganizationMember, error) {
+				if err := s.RemoveOrganizationMember(ctx, orgID, userID); err != nil {
+					return nil, err
+				}
+				return &have, nil
+			}, func(r *SyncReport, m OrganizationMember) { r.Removed = append(r.Removed, m) })
+		}
+	}
+
+	wg.Wait()
+	return report, nil
+}
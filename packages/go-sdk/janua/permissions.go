@@ -0,0 +1,36 @@
+package janua
+
+import (
+	"context"
+	"net/http"
+)
+
+// PermissionsService reads the global permission catalog: the full set of
+// permission strings the API recognizes, independent of any organization's
+// role assignments (see OrganizationsService's OrganizationRole CRUD for
+// that).
+type PermissionsService struct {
+	client *Client
+}
+
+// Permission describes one permission the API enforces.
+type Permission struct {
+	Key         string `json:"key"`
+	Description string `json:"description,omitempty"`
+	Category    string `json:"category,omitempty"`
+}
+
+// ListPermissions lists every permission in the global catalog.
+func (s *PermissionsService) ListPermissions(ctx context.Context) ([]Permission, error) {
+	resp, err := s.client.request(ctx, http.MethodGet, "/api/v1/permissions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var perms []Permission
+	if err := decodeResponse(resp, &perms); err != nil {
+		return nil, err
+	}
+
+	return perms, nil
+}
@@ -0,0 +1,155 @@
+package janua
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultDecisionCacheTTL bounds how long RequirePermission reuses a
+// cached Decision before re-checking, so a revoked permission takes
+// effect within a bounded window rather than indefinitely.
+const defaultDecisionCacheTTL = 1 * time.Minute
+
+// decisionCache caches Decisions keyed by (user, permission, resource),
+// each valid until its own expiry, so repeated requests for the same
+// check don't each round-trip to the API.
+type decisionCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cachedDecision
+}
+
+type cachedDecision struct {
+	decision Decision
+	expires  time.Time
+}
+
+func newDecisionCache(ttl time.Duration) *decisionCache {
+	return &decisionCache{ttl: ttl, entries: make(map[string]cachedDecision)}
+}
+
+func (c *decisionCache) get(key string) (Decision, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return Decision{}, false
+	}
+	return entry.decision, true
+}
+
+func (c *decisionCache) set(key string, decision Decision) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = cachedDecision{decision: decision, expires: time.Now().Add(c.ttl)}
+}
+
+func decisionCacheKey(userID, permission string, resource ResourceRef) string {
+	return userID + "|" + permission + "|" + resource.Type + "|" + resource.ID
+}
+
+// RequirePermissionOption configures RequirePermission.
+type RequirePermissionOption func(*requirePermissionConfig)
+
+type requirePermissionConfig struct {
+	resource func(*http.Request) ResourceRef
+	cacheTTL time.Duration
+}
+
+// WithResource derives the ResourceRef a permission is checked against
+// from the incoming request (e.g. an org ID path parameter). Without
+// this option, every check uses the zero ResourceRef.
+func WithResource(fn func(*http.Request) ResourceRef) RequirePermissionOption {
+	return func(c *requirePermissionConfig) { c.resource = fn }
+}
+
+// WithCacheTTL overrides how long RequirePermission caches a Decision per
+// (user, permission, resource). Defaults to defaultDecisionCacheTTL.
+func WithCacheTTL(ttl time.Duration) RequirePermissionOption {
+	return func(c *requirePermissionConfig) { c.cacheTTL = ttl }
+}
+
+// bearerToken extracts the token from a request's "Authorization: Bearer
+// <token>" header, or "" if the header is missing or a different scheme.
+func bearerToken(r *http.Request) string {
+	const prefix = "Bearer "
+	auth := r.Header.Get("Authorization")
+	if len(auth) <= len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return ""
+	}
+	return auth[len(prefix):]
+}
+
+// RequirePermission returns middleware that resolves the caller from the
+// incoming request's own "Authorization: Bearer <token>" header - not
+// client's stored credentials, which belong to whoever constructed client
+// - checks permission against the ResourceRef opts derives from the
+// request (if any), and responds 403 with a structured JSON error body
+// when denied or when the caller can't be resolved. Decisions are cached
+// in-process per (user, permission, resource) for the configured TTL.
+func RequirePermission(client *Client, permission string, opts ...RequirePermissionOption) func(http.Handler) http.Handler {
+	cfg := requirePermissionConfig{cacheTTL: defaultDecisionCacheTTL}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	cache := newDecisionCache(cfg.cacheTTL)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var resource ResourceRef
+			if cfg.resource != nil {
+				resource = cfg.resource(r)
+			}
+
+			token := bearerToken(r)
+			if token == "" {
+				writePermissionDenied(w, "missing bearer token")
+				return
+			}
+			caller := client.WithToken(token)
+
+			user, err := caller.Users.GetCurrentUser(r.Context())
+			if err != nil {
+				writePermissionDenied(w, "unable to resolve caller")
+				return
+			}
+
+			key := decisionCacheKey(user.ID, permission, resource)
+			decision, ok := cache.get(key)
+			if !ok {
+				allowed, got, err := caller.Users.CheckPermission(r.Context(), user.ID, permission, resource)
+				if err != nil {
+					writePermissionDenied(w, "permission check failed")
+					return
+				}
+				if got != nil {
+					decision = *got
+				} else {
+					decision = Decision{Permission: permission, Resource: resource, Allowed: allowed}
+				}
+				cache.set(key, decision)
+			}
+
+			if !decision.Allowed {
+				writePermissionDenied(w, decision.Reason)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writePermissionDenied(w http.ResponseWriter, reason string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	json.NewEncoder(w).Encode(map[string]string{
+		"error":  "forbidden",
+		"reason": reason,
+	})
+}
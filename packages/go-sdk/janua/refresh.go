@@ -0,0 +1,105 @@
+package janua
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// refreshPath is excluded from refresh-and-retry handling so a 401 from the
+// refresh endpoint itself can't trigger another refresh attempt.
+const refreshPath = "/api/v1/auth/refresh"
+
+// refreshingTransport wraps an http.RoundTripper so a 401 response
+// transparently triggers one call to AuthService.RefreshToken using the
+// client's stored refresh token, then retries the original request once
+// with the new access token. Concurrent requests that all hit a 401 at once
+// share a single refresh via sfGroup, rather than each racing the server
+// with their own refresh call.
+type refreshingTransport struct {
+	next   http.RoundTripper
+	client *Client
+	sf     *sfGroup
+}
+
+// newRefreshingTransport returns a refreshingTransport wrapping next (which
+// defaults to http.DefaultTransport if nil).
+func newRefreshingTransport(next http.RoundTripper, client *Client) *refreshingTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &refreshingTransport{next: next, client: client, sf: &sfGroup{}}
+}
+
+func (t *refreshingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	if strings.HasSuffix(req.URL.Path, refreshPath) {
+		return resp, nil
+	}
+
+	refreshToken := t.client.currentRefreshToken()
+	if refreshToken == "" {
+		return resp, nil
+	}
+
+	retryReq, cloneErr := cloneRetryableRequest(req)
+	if cloneErr != nil {
+		// Body isn't replayable (no GetBody); return the original 401
+		// rather than risk sending a truncated request.
+		return resp, nil
+	}
+
+	if _, refreshErr := t.sf.do("refresh", func() (any, error) {
+		_, err := t.client.Auth.RefreshToken(req.Context(), refreshToken)
+		return nil, err
+	}); refreshErr != nil {
+		return resp, nil
+	}
+
+	resp.Body.Close()
+	retryReq.Header.Set("Authorization", "Bearer "+t.client.currentAccessToken())
+	return t.next.RoundTrip(retryReq)
+}
+
+// sfGroup is a minimal single-flight group: concurrent do() calls sharing a
+// key wait for the first caller's fn to finish and share its result, rather
+// than each running fn themselves. This keeps concurrent 401s from
+// stampeding the token refresh endpoint.
+type sfGroup struct {
+	mu    sync.Mutex
+	calls map[string]*sfCall
+}
+
+type sfCall struct {
+	done   chan struct{}
+	result any
+	err    error
+}
+
+func (g *sfGroup) do(key string, fn func() (any, error)) (any, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*sfCall)
+	}
+	if call, inFlight := g.calls[key]; inFlight {
+		g.mu.Unlock()
+		<-call.done
+		return call.result, call.err
+	}
+
+	call := &sfCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.result, call.err = fn()
+	close(call.done)
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+
+	return call.result, call.err
+}
@@ -3,12 +3,47 @@ package janua
 
 import (
 	"context"
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
 	"math"
 	"math/rand"
+	"net"
 	"net/http"
+	"strconv"
+	"sync"
+	"syscall"
 	"time"
 )
 
+// Named retry conditions for RetryConfig.RetryOn, in the spirit of the
+// Envoy/Consul retry-on policy vocabulary. A request is retried if any
+// condition it satisfies (see classifyRetryConditions) appears in RetryOn.
+const (
+	// RetryOnReset matches a TCP connection reset (ECONNRESET).
+	RetryOnReset = "reset"
+	// RetryOnConnectFailure matches any failure to establish or maintain
+	// the connection: dial errors, refused connections, and unexpected EOF.
+	RetryOnConnectFailure = "connect-failure"
+	// RetryOnRefusedStream matches a connection actively refused
+	// (ECONNREFUSED).
+	RetryOnRefusedStream = "refused-stream"
+	// RetryOn5xx matches any 5xx response status.
+	RetryOn5xx = "5xx"
+	// RetryOnGatewayError matches the 502/503/504 subset of 5xx responses.
+	RetryOnGatewayError = "gateway-error"
+	// RetryOnRetriable4xx matches 4xx responses known to be safe to retry:
+	// 408 Request Timeout, 409 Conflict, and 429 Too Many Requests.
+	RetryOnRetriable4xx = "retriable-4xx"
+	// RetryOnCancelled matches a request cancelled via context.Canceled.
+	RetryOnCancelled = "cancelled"
+	// RetryOnDeadlineExceeded matches a request that timed out via
+	// context.DeadlineExceeded.
+	RetryOnDeadlineExceeded = "deadline-exceeded"
+)
+
 // RetryConfig configures retry behavior
 type RetryConfig struct {
 	// MaxAttempts is the maximum number of retry attempts (including initial request)
@@ -21,12 +56,50 @@ type RetryConfig struct {
 	ExponentialBase float64
 	// Jitter adds randomness to delay to prevent thundering herd
 	Jitter bool
-	// RetryIf is a function that determines if an error should trigger a retry
+	// RetryIf is a function that determines if an error should trigger a retry.
+	// Deprecated: prefer RetryOn, which covers the same decisions declaratively
+	// and without a hand-written predicate. RetryIf is kept as a lower-level
+	// override: if set, it takes precedence over RetryOn.
 	RetryIf func(error) bool
-	// RetryStatusCodes is a list of HTTP status codes that should trigger a retry
+	// RetryStatusCodes is a list of HTTP status codes that should trigger a retry.
+	// Deprecated: prefer RetryOn (e.g. "5xx", "retriable-4xx"), which expresses
+	// the same intent without enumerating codes by hand.
 	RetryStatusCodes []int
+	// RetryOn is the recommended way to configure which failures are retried.
+	// It holds one or more named conditions (RetryOnReset, RetryOnConnectFailure,
+	// RetryOnRefusedStream, RetryOn5xx, RetryOnGatewayError, RetryOnRetriable4xx,
+	// RetryOnCancelled, RetryOnDeadlineExceeded); an error is retried if it
+	// satisfies any condition in the list. RetryOn is only consulted when
+	// RetryIf is nil, so it composes cleanly with DefaultRetryConfig and with
+	// hand-written RetryIf overrides. Empty by default for backward
+	// compatibility with callers that only set RetryStatusCodes.
+	RetryOn []string
 	// OnRetry is called before each retry with the attempt number and error
 	OnRetry func(attempt int, err error, delay time.Duration)
+	// RetryAfterMax bounds how long we will honor a server-provided
+	// Retry-After header (parsed from 429/503 responses, in either
+	// delta-seconds or HTTP-date form) before falling back to exponential
+	// backoff. Zero means ignore the header entirely.
+	RetryAfterMax time.Duration
+	// RetryAfterMin floors the honored Retry-After delay, in case a server
+	// advertises an unreasonably short retry window. Zero means no floor.
+	RetryAfterMin time.Duration
+	// HedgeAfter, if non-zero, enables hedged requests: if the original
+	// request hasn't completed after this long, RetryableClient launches an
+	// additional in-flight request without cancelling the first, and
+	// returns whichever succeeds first. Hedging only applies to requests
+	// whose context was marked via MarkHedgeable, since it's only safe for
+	// idempotent requests.
+	HedgeAfter time.Duration
+	// MaxHedged caps how many additional hedged requests may be in flight
+	// at once (beyond the original). Ignored if HedgeAfter is zero.
+	MaxHedged int
+	// Strategy computes the delay before each retry. When non-nil, it takes
+	// precedence over BaseDelay/MaxDelay/ExponentialBase/Jitter, which only
+	// configure the default ExponentialBackoff strategy. See
+	// ExponentialBackoff, ExponentialWithFullJitter,
+	// ExponentialWithEqualJitter, DecorrelatedJitter, and ConstantBackoff.
+	Strategy BackoffStrategy
 }
 
 // DefaultRetryConfig returns sensible retry defaults
@@ -44,7 +117,8 @@ func DefaultRetryConfig() *RetryConfig {
 			http.StatusServiceUnavailable,  // 503
 			http.StatusGatewayTimeout,      // 504
 		},
-		RetryIf: DefaultRetryIf,
+		RetryIf:       DefaultRetryIf,
+		RetryAfterMax: 60 * time.Second,
 	}
 }
 
@@ -77,7 +151,8 @@ func DefaultRetryIf(err error) bool {
 
 // Retryer provides retry functionality for HTTP requests
 type Retryer struct {
-	config *RetryConfig
+	config   *RetryConfig
+	strategy BackoffStrategy
 }
 
 // NewRetryer creates a new Retryer with the given config
@@ -88,10 +163,22 @@ func NewRetryer(config *RetryConfig) *Retryer {
 	if config.ExponentialBase == 0 {
 		config.ExponentialBase = 2.0
 	}
-	if config.RetryIf == nil {
+	if config.RetryIf == nil && len(config.RetryOn) == 0 {
 		config.RetryIf = DefaultRetryIf
 	}
-	return &Retryer{config: config}
+
+	strategy := config.Strategy
+	if strategy == nil {
+		strategy = &ExponentialBackoff{
+			BaseDelay:       config.BaseDelay,
+			MaxDelay:        config.MaxDelay,
+			ExponentialBase: config.ExponentialBase,
+			Jitter:          config.Jitter,
+			rng:             newSeededRand(),
+		}
+	}
+
+	return &Retryer{config: config, strategy: strategy}
 }
 
 // DoFunc represents a function that performs an operation and returns an error
@@ -199,41 +286,259 @@ func DoWithResult[T any](ctx context.Context, r *Retryer, fn DoFuncWithResult[T]
 	return zero, lastErr
 }
 
-// shouldRetry determines if an error should trigger a retry
+// shouldRetry determines if an error should trigger a retry. RetryIf, when
+// set, is the override and takes precedence; otherwise RetryOn is consulted
+// if non-empty.
 func (r *Retryer) shouldRetry(err error) bool {
 	if r.config.RetryIf != nil {
 		return r.config.RetryIf(err)
 	}
+	if len(r.config.RetryOn) > 0 {
+		return retryOnMatches(classifyRetryConditions(err), r.config.RetryOn)
+	}
+	return false
+}
+
+// classifyRetryConditions returns the set of named RetryOn conditions that
+// describe err, so that it can be compared against a caller's RetryOn list.
+// An error can match more than one condition (e.g. a gateway-error response
+// is also a 5xx).
+func classifyRetryConditions(err error) []string {
+	switch e := err.(type) {
+	case *RateLimitError:
+		return classifyStatusRetryConditions(e.StatusCode)
+	case *JanuaError:
+		return classifyStatusRetryConditions(e.StatusCode)
+	case *NetworkError:
+		return classifyErrorRetryConditions(e.Cause)
+	default:
+		return classifyErrorRetryConditions(err)
+	}
+}
+
+// classifyErrorRetryConditions inspects a transport-level error (a dial
+// failure, a reset connection, a cancelled or timed-out context) and returns
+// the RetryOn conditions it satisfies.
+func classifyErrorRetryConditions(err error) []string {
+	if err == nil {
+		return nil
+	}
+
+	var conditions []string
+	switch {
+	case errors.Is(err, context.Canceled):
+		conditions = append(conditions, RetryOnCancelled)
+	case errors.Is(err, context.DeadlineExceeded):
+		conditions = append(conditions, RetryOnDeadlineExceeded)
+	case errors.Is(err, syscall.ECONNRESET):
+		conditions = append(conditions, RetryOnReset)
+	case errors.Is(err, syscall.ECONNREFUSED):
+		conditions = append(conditions, RetryOnRefusedStream, RetryOnConnectFailure)
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		conditions = append(conditions, RetryOnConnectFailure)
+	default:
+		var opErr *net.OpError
+		if errors.As(err, &opErr) {
+			conditions = append(conditions, RetryOnConnectFailure)
+		}
+	}
+	return conditions
+}
+
+// classifyStatusRetryConditions returns the RetryOn conditions an HTTP
+// status code satisfies.
+func classifyStatusRetryConditions(status int) []string {
+	var conditions []string
+	switch {
+	case status >= 500 && status < 600:
+		conditions = append(conditions, RetryOn5xx)
+		switch status {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			conditions = append(conditions, RetryOnGatewayError)
+		}
+	case status == http.StatusRequestTimeout, status == http.StatusConflict, status == http.StatusTooManyRequests:
+		conditions = append(conditions, RetryOnRetriable4xx)
+	}
+	return conditions
+}
+
+// retryOnMatches reports whether conditions and retryOn share at least one
+// entry.
+func retryOnMatches(conditions, retryOn []string) bool {
+	for _, want := range retryOn {
+		for _, have := range conditions {
+			if want == have {
+				return true
+			}
+		}
+	}
 	return false
 }
 
 // calculateDelay calculates the delay before the next retry
 func (r *Retryer) calculateDelay(attempt int, err error) time.Duration {
-	// Check for rate limit error with RetryAfter
-	if rlErr, ok := err.(*RateLimitError); ok && rlErr.RetryAfter > 0 {
-		return rlErr.RetryAfter
+	// Honor a server-provided Retry-After, within RetryAfterMin/Max, unless
+	// RetryAfterMax is zero (meaning the header is ignored entirely).
+	if rlErr, ok := err.(*RateLimitError); ok && rlErr.RetryAfter > 0 && r.config.RetryAfterMax > 0 {
+		delay := rlErr.RetryAfter
+		if delay > r.config.RetryAfterMax {
+			delay = r.config.RetryAfterMax
+		}
+		if delay < r.config.RetryAfterMin {
+			delay = r.config.RetryAfterMin
+		}
+		return delay
 	}
 
-	// Calculate exponential backoff
-	multiplier := math.Pow(r.config.ExponentialBase, float64(attempt-1))
-	delay := time.Duration(float64(r.config.BaseDelay) * multiplier)
+	return r.strategy.NextDelay(attempt, err)
+}
+
+// BackoffStrategy computes the delay to wait before a given retry attempt
+// (1-indexed: the attempt that just failed). Implementations that need
+// randomness should draw from their own *rand.Rand rather than the global
+// math/rand source, so concurrent Retryers don't contend on its lock; see
+// newSeededRand.
+type BackoffStrategy interface {
+	NextDelay(attempt int, err error) time.Duration
+}
+
+// newSeededRand returns a *rand.Rand seeded from crypto/rand, falling back
+// to the current time if that fails (e.g. on a platform without a CSPRNG).
+// Every Retryer gets its own instance so concurrent retries don't contend
+// on the global math/rand lock.
+func newSeededRand() *rand.Rand {
+	var seedBytes [8]byte
+	seed := time.Now().UnixNano()
+	if _, err := cryptorand.Read(seedBytes[:]); err == nil {
+		seed = int64(binary.LittleEndian.Uint64(seedBytes[:]))
+	}
+	return rand.New(rand.NewSource(seed)) //nolint:gosec // G404: seeded from crypto/rand above; this is just a fast per-Retryer source
+}
+
+// ExponentialBackoff is the classic `base * exponentialBase^(attempt-1)`
+// backoff, capped at MaxDelay, with optional ±25% jitter. This is the
+// strategy RetryConfig builds by default from BaseDelay/MaxDelay/
+// ExponentialBase/Jitter when Strategy is nil.
+type ExponentialBackoff struct {
+	BaseDelay       time.Duration
+	MaxDelay        time.Duration
+	ExponentialBase float64
+	Jitter          bool
+	// rng is optional; if nil, the global math/rand source is used.
+	rng *rand.Rand
+}
 
-	// Apply max delay cap
-	if delay > r.config.MaxDelay {
-		delay = r.config.MaxDelay
+// NextDelay implements BackoffStrategy
+func (s *ExponentialBackoff) NextDelay(attempt int, _ error) time.Duration {
+	base := s.ExponentialBase
+	if base == 0 {
+		base = 2.0
 	}
 
-	// Apply jitter if enabled
-	if r.config.Jitter {
-		// Add random jitter of ±25%
+	multiplier := math.Pow(base, float64(attempt-1))
+	delay := time.Duration(float64(s.BaseDelay) * multiplier)
+
+	if delay > s.MaxDelay {
+		delay = s.MaxDelay
+	}
+
+	if s.Jitter {
 		jitterRange := float64(delay) * 0.25
-		jitter := (rand.Float64()*2 - 1) * jitterRange //nolint:gosec // G404: Jitter doesn't need cryptographic randomness
+		jitter := (randFloat64(s.rng)*2 - 1) * jitterRange
 		delay = time.Duration(float64(delay) + jitter)
 	}
 
 	return delay
 }
 
+// ExponentialWithFullJitter is the AWS "full jitter" strategy:
+// sleep = rand(0, min(cap, base*2^attempt)).
+type ExponentialWithFullJitter struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// rng is optional; if nil, the global math/rand source is used.
+	rng *rand.Rand
+}
+
+// NextDelay implements BackoffStrategy
+func (s *ExponentialWithFullJitter) NextDelay(attempt int, _ error) time.Duration {
+	capped := math.Min(float64(s.MaxDelay), float64(s.BaseDelay)*math.Pow(2, float64(attempt)))
+	return time.Duration(randFloat64(s.rng) * capped)
+}
+
+// ExponentialWithEqualJitter is the AWS "equal jitter" strategy:
+// sleep = temp/2 + rand(0, temp/2), where temp = min(cap, base*2^attempt).
+// It never sleeps less than half the uncapped exponential delay, trading
+// some thundering-herd protection for a higher delay floor than full jitter.
+type ExponentialWithEqualJitter struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// rng is optional; if nil, the global math/rand source is used.
+	rng *rand.Rand
+}
+
+// NextDelay implements BackoffStrategy
+func (s *ExponentialWithEqualJitter) NextDelay(attempt int, _ error) time.Duration {
+	temp := math.Min(float64(s.MaxDelay), float64(s.BaseDelay)*math.Pow(2, float64(attempt)))
+	return time.Duration(temp/2 + randFloat64(s.rng)*temp/2)
+}
+
+// DecorrelatedJitter is the AWS "decorrelated jitter" strategy:
+// sleep = min(cap, rand(base, prev*3)), where prev is the delay returned by
+// the previous call. It is stateful across attempts and safe for concurrent
+// use; a zero-value DecorrelatedJitter starts from BaseDelay.
+type DecorrelatedJitter struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// rng is optional; if nil, the global math/rand source is used.
+	rng *rand.Rand
+
+	mu   sync.Mutex
+	prev time.Duration
+}
+
+// NextDelay implements BackoffStrategy
+func (s *DecorrelatedJitter) NextDelay(_ int, _ error) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prev := s.prev
+	if prev == 0 {
+		prev = s.BaseDelay
+	}
+
+	span := float64(prev*3) - float64(s.BaseDelay)
+	if span < 0 {
+		span = 0
+	}
+	delay := float64(s.BaseDelay) + randFloat64(s.rng)*span
+	if delay > float64(s.MaxDelay) {
+		delay = float64(s.MaxDelay)
+	}
+
+	s.prev = time.Duration(delay)
+	return s.prev
+}
+
+// ConstantBackoff always waits the same Delay between retries.
+type ConstantBackoff struct {
+	Delay time.Duration
+}
+
+// NextDelay implements BackoffStrategy
+func (s *ConstantBackoff) NextDelay(_ int, _ error) time.Duration {
+	return s.Delay
+}
+
+// randFloat64 draws from rng if set, falling back to the global math/rand
+// source otherwise.
+func randFloat64(rng *rand.Rand) float64 {
+	if rng != nil {
+		return rng.Float64()
+	}
+	return rand.Float64() //nolint:gosec // G404: backoff jitter doesn't need cryptographic randomness
+}
+
 // WithRetry is a convenience function for simple retry operations
 func WithRetry[T any](ctx context.Context, config *RetryConfig, fn DoFuncWithResult[T]) (T, error) {
 	retryer := NewRetryer(config)
@@ -249,25 +554,56 @@ func WithDefaultRetry[T any](ctx context.Context, fn DoFuncWithResult[T]) (T, er
 type RetryableClient struct {
 	client  *http.Client
 	retryer *Retryer
+	breaker *CircuitBreaker
+}
+
+// RetryableClientOption configures optional RetryableClient behavior.
+type RetryableClientOption func(*RetryableClient)
+
+// WithCircuitBreaker routes every attempt through cb, so that an open
+// circuit fails fast with ErrCircuitOpen instead of sleeping through the
+// full retry/backoff schedule.
+func WithCircuitBreaker(cb *CircuitBreaker) RetryableClientOption {
+	return func(rc *RetryableClient) {
+		rc.breaker = cb
+	}
 }
 
 // NewRetryableClient creates a new HTTP client with retry functionality
-func NewRetryableClient(client *http.Client, config *RetryConfig) *RetryableClient {
+func NewRetryableClient(client *http.Client, config *RetryConfig, opts ...RetryableClientOption) *RetryableClient {
 	if client == nil {
 		client = http.DefaultClient
 	}
-	return &RetryableClient{
+	rc := &RetryableClient{
 		client:  client,
 		retryer: NewRetryer(config),
 	}
+	for _, opt := range opts {
+		opt(rc)
+	}
+	return rc
 }
 
-// Do performs an HTTP request with retry logic
+// Do performs an HTTP request with retry logic, hedging it per HedgeAfter/
+// MaxHedged when the request's context was marked via MarkHedgeable.
 func (rc *RetryableClient) Do(req *http.Request) (*http.Response, error) {
+	cfg := rc.retryer.config
+	if cfg.HedgeAfter > 0 && cfg.MaxHedged > 0 && isHedgeable(req.Context()) {
+		return rc.doHedged(req)
+	}
+	return rc.doOnce(req)
+}
+
+// doOnce performs a single (non-hedged) request with retry logic. Each
+// attempt is routed through the circuit breaker, if one was configured via
+// WithCircuitBreaker, so an open circuit short-circuits the retry loop
+// immediately with ErrCircuitOpen rather than retrying into a failing
+// dependency.
+func (rc *RetryableClient) doOnce(req *http.Request) (*http.Response, error) {
 	var resp *http.Response
 	var lastErr error
 
-	err := rc.retryer.Do(req.Context(), func() error {
+	attempt := func() error {
 		var err error
 		resp, err = rc.client.Do(req)
 		if err != nil {
@@ -275,6 +611,22 @@ func (rc *RetryableClient) Do(req *http.Request) (*http.Response, error) {
 			return lastErr
 		}
 
+		// 429/503 carry a Retry-After the retryer should honor; parse it
+		// once here so calculateDelay doesn't need to re-read the response.
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+			resp.Body.Close()
+			lastErr = &RateLimitError{
+				JanuaError: JanuaError{
+					Code:       ErrCodeRateLimit,
+					Message:    http.StatusText(resp.StatusCode),
+					StatusCode: resp.StatusCode,
+				},
+				RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+			}
+			return lastErr
+		}
+
 		// Check if status code should trigger retry
 		for _, code := range rc.retryer.config.RetryStatusCodes {
 			if resp.StatusCode == code {
@@ -290,6 +642,13 @@ func (rc *RetryableClient) Do(req *http.Request) (*http.Response, error) {
 		}
 
 		return nil
+	}
+
+	err := rc.retryer.Do(req.Context(), func() error {
+		if rc.breaker != nil {
+			return rc.breaker.Execute(attempt)
+		}
+		return attempt()
 	})
 
 	if err != nil {
@@ -299,20 +658,143 @@ func (rc *RetryableClient) Do(req *http.Request) (*http.Response, error) {
 	return resp, lastErr
 }
 
-// CircuitBreaker provides circuit breaker functionality
+// hedgedResult carries one racer's outcome back to doHedged.
+type hedgedResult struct {
+	resp *http.Response
+	err  error
+}
+
+// doHedged races the original request against up to MaxHedged additional
+// requests, each launched HedgeAfter after the previous one if no result
+// has arrived yet, and returns the first successful response. If every
+// racer fails, the last error observed is returned.
+func (rc *RetryableClient) doHedged(req *http.Request) (*http.Response, error) {
+	cfg := rc.retryer.config
+	ctx := req.Context()
+	results := make(chan hedgedResult, cfg.MaxHedged+1)
+
+	launch := func(r *http.Request) {
+		resp, err := rc.doOnce(r)
+		results <- hedgedResult{resp: resp, err: err}
+	}
+	go launch(req)
+
+	timer := time.NewTimer(cfg.HedgeAfter)
+	defer timer.Stop()
+
+	launched := 1
+	completed := 0
+	var lastErr error
+
+	for {
+		select {
+		case res := <-results:
+			completed++
+			if res.err == nil {
+				return res.resp, nil
+			}
+			lastErr = res.err
+			if launched <= cfg.MaxHedged {
+				// A racer failed but we still have hedge budget: launch its
+				// replacement immediately rather than waiting out the rest
+				// of the current hedge interval.
+				launched++
+				hedgeReq, cerr := cloneRetryableRequest(req)
+				if cerr == nil {
+					go launch(hedgeReq)
+					timer.Reset(cfg.HedgeAfter)
+					continue
+				}
+				launched--
+			}
+			if completed == launched {
+				return nil, lastErr
+			}
+		case <-timer.C:
+			if launched <= cfg.MaxHedged {
+				launched++
+				hedgeReq, err := cloneRetryableRequest(req)
+				if err == nil {
+					go launch(hedgeReq)
+				} else {
+					launched--
+				}
+				timer.Reset(cfg.HedgeAfter)
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// cloneRetryableRequest returns a clone of req safe to send as an
+// additional attempt, rewinding its body via GetBody when present. If req
+// has no body (GetBody is nil), req itself is returned unchanged.
+func cloneRetryableRequest(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone, nil
+}
+
+// Counts tracks request outcomes within the circuit breaker's current
+// generation (the span since it was last closed, opened, or had its
+// interval elapse). It is reset on every state transition and, in the
+// closed state, every CircuitBreakerConfig.Interval.
+type Counts struct {
+	Requests             uint32
+	TotalSuccesses       uint32
+	TotalFailures        uint32
+	ConsecutiveSuccesses uint32
+	ConsecutiveFailures  uint32
+}
+
+func (c *Counts) onRequest() {
+	c.Requests++
+}
+
+func (c *Counts) onSuccess() {
+	c.TotalSuccesses++
+	c.ConsecutiveSuccesses++
+	c.ConsecutiveFailures = 0
+}
+
+func (c *Counts) onFailure() {
+	c.TotalFailures++
+	c.ConsecutiveFailures++
+	c.ConsecutiveSuccesses = 0
+}
+
+func (c *Counts) clear() {
+	*c = Counts{}
+}
+
+// CircuitBreaker provides circuit breaker functionality. It is safe for
+// concurrent use by multiple goroutines.
 type CircuitBreaker struct {
-	// State tracking
-	failures    int
-	successes   int
-	lastFailure time.Time
-	state       CircuitState
-
-	// Configuration
-	failureThreshold int
+	name string
+
+	mu     sync.Mutex
+	state  CircuitState
+	counts Counts
+	// expiry marks the end of the current generation: in CircuitOpen it's
+	// when the breaker may move to CircuitHalfOpen; in CircuitClosed it's
+	// when counts are next reset (if interval > 0).
+	expiry time.Time
+
 	successThreshold int
 	timeout          time.Duration
+	interval         time.Duration
 	halfOpenMaxCalls int
 	halfOpenCalls    int
+	readyToTrip      func(Counts) bool
+	onStateChange    func(name string, from, to CircuitState)
 }
 
 // CircuitState represents the state of the circuit breaker
@@ -326,14 +808,39 @@ const (
 
 // CircuitBreakerConfig configures the circuit breaker
 type CircuitBreakerConfig struct {
-	// FailureThreshold is the number of failures before opening the circuit
+	// Name distinguishes this breaker in observability output (metrics,
+	// logging) when an application runs more than one.
+	Name string
+	// FailureThreshold is the number of consecutive failures before opening
+	// the circuit. It only takes effect when ReadyToTrip is nil; it exists
+	// for simple callers who don't need ratio-based tripping.
 	FailureThreshold int
-	// SuccessThreshold is the number of successes needed to close the circuit
+	// SuccessThreshold is the number of consecutive successes needed in the
+	// half-open state to close the circuit.
 	SuccessThreshold int
-	// Timeout is how long the circuit stays open before half-opening
+	// Timeout is how long the circuit stays open before half-opening.
 	Timeout time.Duration
-	// HalfOpenMaxCalls is the max concurrent calls in half-open state
+	// HalfOpenMaxCalls is the max concurrent calls allowed in half-open state.
 	HalfOpenMaxCalls int
+	// Interval is how often, while closed, the Counts are reset to zero.
+	// Zero means counts only reset on a state transition, i.e. they
+	// accumulate for the lifetime of the closed state.
+	Interval time.Duration
+	// ReadyToTrip is called after every failure while closed with the
+	// current Counts; the circuit opens when it returns true. This is the
+	// recommended way to configure tripping, since it can combine a minimum
+	// request volume with a failure ratio, e.g.:
+	//
+	//	ReadyToTrip: func(c Counts) bool {
+	//		return c.Requests >= 20 && float64(c.TotalFailures)/float64(c.Requests) > 0.5
+	//	}
+	//
+	// If nil, a default based on FailureThreshold (consecutive failures) is
+	// used.
+	ReadyToTrip func(Counts) bool
+	// OnStateChange is called whenever the breaker transitions between
+	// states, for metrics or logging.
+	OnStateChange func(name string, from, to CircuitState)
 }
 
 // DefaultCircuitBreakerConfig returns sensible defaults
@@ -351,13 +858,30 @@ func NewCircuitBreaker(config *CircuitBreakerConfig) *CircuitBreaker {
 	if config == nil {
 		config = DefaultCircuitBreakerConfig()
 	}
-	return &CircuitBreaker{
+
+	readyToTrip := config.ReadyToTrip
+	if readyToTrip == nil {
+		threshold := config.FailureThreshold
+		if threshold == 0 {
+			threshold = 5
+		}
+		readyToTrip = func(c Counts) bool {
+			return c.ConsecutiveFailures >= uint32(threshold)
+		}
+	}
+
+	cb := &CircuitBreaker{
+		name:             config.Name,
 		state:            CircuitClosed,
-		failureThreshold: config.FailureThreshold,
 		successThreshold: config.SuccessThreshold,
 		timeout:          config.Timeout,
+		interval:         config.Interval,
 		halfOpenMaxCalls: config.HalfOpenMaxCalls,
+		readyToTrip:      readyToTrip,
+		onStateChange:    config.OnStateChange,
 	}
+	cb.toNewGeneration(time.Now())
+	return cb
 }
 
 // ErrCircuitOpen is returned when the circuit is open
@@ -366,68 +890,150 @@ var ErrCircuitOpen = &JanuaError{
 	Message: "Circuit breaker is open",
 }
 
-// Execute runs a function through the circuit breaker
+// Name returns the breaker's name, as set by CircuitBreakerConfig.Name.
+func (cb *CircuitBreaker) Name() string {
+	return cb.name
+}
+
+// State returns the current circuit state, applying any pending
+// open-to-half-open or interval-based reset transition first.
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.currentState(time.Now())
+}
+
+// Counts returns a snapshot of the current generation's request counts.
+func (cb *CircuitBreaker) Counts() Counts {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.counts
+}
+
+// Execute runs fn through the circuit breaker
 func (cb *CircuitBreaker) Execute(fn func() error) error {
-	if !cb.allowRequest() {
-		return ErrCircuitOpen
+	if err := cb.beforeRequest(); err != nil {
+		return err
 	}
 
 	err := fn()
 
-	cb.recordResult(err)
+	cb.afterRequest(err == nil)
 
 	return err
 }
 
-// allowRequest checks if a request should be allowed
-func (cb *CircuitBreaker) allowRequest() bool {
-	switch cb.state {
-	case CircuitClosed:
-		return true
+// beforeRequest admits or rejects a request based on the current state.
+func (cb *CircuitBreaker) beforeRequest() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	state := cb.currentState(time.Now())
+	switch state {
 	case CircuitOpen:
-		// Check if timeout has passed
-		if time.Since(cb.lastFailure) > cb.timeout {
-			cb.state = CircuitHalfOpen
-			cb.halfOpenCalls = 0
-			return true
-		}
-		return false
+		return ErrCircuitOpen
 	case CircuitHalfOpen:
-		if cb.halfOpenCalls < cb.halfOpenMaxCalls {
-			cb.halfOpenCalls++
-			return true
+		if cb.halfOpenCalls >= cb.halfOpenMaxCalls {
+			return ErrCircuitOpen
 		}
-		return false
-	default:
-		return false
+		cb.halfOpenCalls++
 	}
+
+	cb.counts.onRequest()
+	return nil
 }
 
-// recordResult records the result of a request
-func (cb *CircuitBreaker) recordResult(err error) {
-	if err != nil {
-		cb.failures++
-		cb.successes = 0
-		cb.lastFailure = time.Now()
+// afterRequest records the outcome of a request admitted by beforeRequest.
+func (cb *CircuitBreaker) afterRequest(success bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
 
-		if cb.failures >= cb.failureThreshold {
-			cb.state = CircuitOpen
-		}
+	now := time.Now()
+	state := cb.currentState(now)
+	if state == CircuitHalfOpen {
+		cb.halfOpenCalls--
+	}
+
+	if success {
+		cb.onSuccess(state, now)
 	} else {
-		cb.successes++
-		cb.failures = 0
+		cb.onFailure(state, now)
+	}
+}
+
+func (cb *CircuitBreaker) onSuccess(state CircuitState, now time.Time) {
+	cb.counts.onSuccess()
+	if state == CircuitHalfOpen && cb.counts.ConsecutiveSuccesses >= uint32(cb.successThreshold) {
+		cb.setState(CircuitClosed, now)
+	}
+}
 
-		if cb.state == CircuitHalfOpen && cb.successes >= cb.successThreshold {
-			cb.state = CircuitClosed
+func (cb *CircuitBreaker) onFailure(state CircuitState, now time.Time) {
+	cb.counts.onFailure()
+	switch state {
+	case CircuitClosed:
+		if cb.readyToTrip(cb.counts) {
+			cb.setState(CircuitOpen, now)
 		}
+	case CircuitHalfOpen:
+		cb.setState(CircuitOpen, now)
 	}
 }
 
-// State returns the current circuit state
-func (cb *CircuitBreaker) State() CircuitState {
+// currentState applies a pending generation transition (open timeout
+// elapsed, or closed interval elapsed) and returns the resulting state.
+// Callers must hold cb.mu.
+func (cb *CircuitBreaker) currentState(now time.Time) CircuitState {
+	switch cb.state {
+	case CircuitClosed:
+		if cb.interval > 0 && !cb.expiry.IsZero() && now.After(cb.expiry) {
+			cb.toNewGeneration(now)
+		}
+	case CircuitOpen:
+		if now.After(cb.expiry) {
+			cb.setState(CircuitHalfOpen, now)
+		}
+	}
 	return cb.state
 }
 
+// setState transitions to state, starting a new generation and firing
+// OnStateChange. Callers must hold cb.mu.
+func (cb *CircuitBreaker) setState(state CircuitState, now time.Time) {
+	if cb.state == state {
+		return
+	}
+
+	prev := cb.state
+	cb.state = state
+	cb.toNewGeneration(now)
+
+	if cb.onStateChange != nil {
+		cb.onStateChange(cb.name, prev, state)
+	}
+}
+
+// toNewGeneration clears counts and recomputes expiry for the current
+// state. Callers must hold cb.mu.
+func (cb *CircuitBreaker) toNewGeneration(now time.Time) {
+	cb.counts.clear()
+	cb.halfOpenCalls = 0
+
+	var zero time.Time
+	switch cb.state {
+	case CircuitClosed:
+		if cb.interval == 0 {
+			cb.expiry = zero
+		} else {
+			cb.expiry = now.Add(cb.interval)
+		}
+	case CircuitOpen:
+		cb.expiry = now.Add(cb.timeout)
+	default:
+		cb.expiry = zero
+	}
+}
+
 // String returns a string representation of the circuit state
 func (s CircuitState) String() string {
 	switch s {
@@ -441,3 +1047,253 @@ func (s CircuitState) String() string {
 		return "unknown"
 	}
 }
+
+// retryPostSafeKey is the context key used to mark a POST request as safe
+// to retry (e.g. because it's idempotent server-side via an idempotency key).
+type retryPostSafeKey struct{}
+
+// MarkPostSafeToRetry returns a context that opts a POST request into retry
+// handling by RetryTransport. POSTs are not retried by default because they
+// are not generally idempotent; use this when the server guarantees
+// idempotency (for example via an Idempotency-Key header).
+func MarkPostSafeToRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryPostSafeKey{}, true)
+}
+
+// hedgeSafeKey is the context key used to mark a request as safe to hedge.
+type hedgeSafeKey struct{}
+
+// MarkHedgeable returns a context that opts a request into RetryConfig's
+// hedging behavior. Hedging is opt-in because it's only safe for idempotent
+// requests: a hedged write could otherwise execute twice server-side.
+func MarkHedgeable(ctx context.Context) context.Context {
+	return context.WithValue(ctx, hedgeSafeKey{}, true)
+}
+
+func isHedgeable(ctx context.Context) bool {
+	safe, _ := ctx.Value(hedgeSafeKey{}).(bool)
+	return safe
+}
+
+func isPostSafeToRetry(ctx context.Context) bool {
+	safe, _ := ctx.Value(retryPostSafeKey{}).(bool)
+	return safe
+}
+
+var defaultIdempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// RetryTransportConfig configures a RetryTransport
+type RetryTransportConfig struct {
+	// MaxAttempts is the maximum number of attempts (including the initial
+	// request). Defaults to 3.
+	MaxAttempts int
+	// BaseDelay is the base of the full-jitter exponential backoff.
+	// Defaults to 500ms.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff before jitter is applied.
+	// Defaults to 30s.
+	MaxDelay time.Duration
+	// MaxElapsedTime bounds the total wall-clock time spent retrying,
+	// including backoff sleeps. Zero means no bound.
+	MaxElapsedTime time.Duration
+	// OnRetry is called before each backoff sleep with the attempt number
+	// (1-indexed), the error that triggered the retry, and how long the
+	// transport will sleep before the next attempt.
+	OnRetry func(attempt int, err error, next time.Duration)
+}
+
+// DefaultRetryTransportConfig returns sensible defaults for RetryTransport
+func DefaultRetryTransportConfig() *RetryTransportConfig {
+	return &RetryTransportConfig{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+	}
+}
+
+// RetriesExhaustedError is returned once RetryTransport has used up its
+// attempt budget. It wraps the last error encountered and reports how
+// much retrying was attempted.
+type RetriesExhaustedError struct {
+	// Attempts is the total number of attempts made, including the first.
+	Attempts int
+	// Elapsed is the cumulative time spent across all attempts and backoffs.
+	Elapsed time.Duration
+	// Cause is the error from the final attempt.
+	Cause error
+}
+
+// Error implements the error interface
+func (e *RetriesExhaustedError) Error() string {
+	return fmt.Sprintf("retries exhausted after %d attempts (%s): %s", e.Attempts, e.Elapsed, e.Cause)
+}
+
+// Unwrap returns the error from the final attempt
+func (e *RetriesExhaustedError) Unwrap() error {
+	return e.Cause
+}
+
+// RetryTransport wraps an http.RoundTripper with full-jitter exponential
+// backoff, honoring Retry-After on 429/503 responses. By default it only
+// retries idempotent methods (GET, HEAD, OPTIONS, PUT, DELETE); POSTs are
+// retried only when the request context was marked via
+// MarkPostSafeToRetry.
+type RetryTransport struct {
+	next   http.RoundTripper
+	config *RetryTransportConfig
+}
+
+// NewRetryTransport wraps next with retry behavior. A nil next uses
+// http.DefaultTransport, and a nil config uses DefaultRetryTransportConfig.
+func NewRetryTransport(next http.RoundTripper, config *RetryTransportConfig) *RetryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if config == nil {
+		config = DefaultRetryTransportConfig()
+	}
+	if config.MaxAttempts == 0 {
+		config.MaxAttempts = 3
+	}
+	if config.BaseDelay == 0 {
+		config.BaseDelay = 500 * time.Millisecond
+	}
+	if config.MaxDelay == 0 {
+		config.MaxDelay = 30 * time.Second
+	}
+	return &RetryTransport{next: next, config: config}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	canRetryMethod := defaultIdempotentMethods[req.Method] ||
+		(req.Method == http.MethodPost && isPostSafeToRetry(req.Context()))
+
+	var lastErr error
+	attemptsMade := 0
+	for attempt := 1; attempt <= t.config.MaxAttempts; attempt++ {
+		attemptsMade = attempt
+		attemptReq := req
+		if attempt > 1 {
+			var err error
+			attemptReq, err = cloneRetryableRequest(req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		retryErr := t.retryableError(resp, err)
+		if retryErr == nil {
+			return resp, nil
+		}
+		if !canRetryMethod {
+			return resp, err
+		}
+		lastErr = retryErr
+
+		if attempt >= t.config.MaxAttempts {
+			break
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		delay := t.nextDelay(attempt, retryErr)
+		if t.config.MaxElapsedTime > 0 && time.Since(start)+delay > t.config.MaxElapsedTime {
+			break
+		}
+
+		if t.config.OnRetry != nil {
+			t.config.OnRetry(attempt, retryErr, delay)
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+
+	return nil, &RetriesExhaustedError{
+		Attempts: attemptsMade,
+		Elapsed:  time.Since(start),
+		Cause:    lastErr,
+	}
+}
+
+// retryableError determines whether resp/err should trigger a retry,
+// returning the error to report (wrapping network errors via
+// NewNetworkError and HTTP errors via the retryer's status code list).
+func (t *RetryTransport) retryableError(resp *http.Response, err error) error {
+	if err != nil {
+		return NewNetworkError(err)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return &RateLimitError{
+			JanuaError: JanuaError{
+				Code:       ErrCodeRateLimit,
+				Message:    http.StatusText(resp.StatusCode),
+				StatusCode: resp.StatusCode,
+			},
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	case http.StatusBadGateway, http.StatusGatewayTimeout, http.StatusInternalServerError:
+		return &JanuaError{
+			Code:       "HTTP_ERROR",
+			Message:    http.StatusText(resp.StatusCode),
+			StatusCode: resp.StatusCode,
+		}
+	default:
+		return nil
+	}
+}
+
+// nextDelay computes the full-jitter exponential backoff delay for the
+// given attempt, honoring RateLimitError.RetryAfter when present:
+// sleep = rand(0, min(cap, base*2^attempt)).
+func (t *RetryTransport) nextDelay(attempt int, err error) time.Duration {
+	if rlErr, ok := err.(*RateLimitError); ok && rlErr.RetryAfter > 0 {
+		return rlErr.RetryAfter
+	}
+
+	return fullJitterBackoff(attempt, t.config.BaseDelay, t.config.MaxDelay)
+}
+
+// fullJitterBackoff computes a full-jitter exponential backoff delay for
+// the given attempt (1-indexed): sleep = rand(0, min(max, base*2^attempt)).
+// Shared by RetryTransport and the Watch stream reconnect loop so both use
+// the same backoff policy.
+func fullJitterBackoff(attempt int, base, max time.Duration) time.Duration {
+	capped := math.Min(
+		float64(max),
+		float64(base)*math.Pow(2, float64(attempt)),
+	)
+	return time.Duration(rand.Float64() * capped) //nolint:gosec // G404: backoff jitter doesn't need cryptographic randomness
+}
+
+// parseRetryAfter parses a Retry-After header value, which per RFC 9110 is
+// either a number of delta-seconds or an HTTP-date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,155 @@
+package janua
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// roundTripFunc adapts a func to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	future := time.Now().Add(2 * time.Minute).UTC()
+
+	cases := []struct {
+		name    string
+		value   string
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{name: "empty header", value: "", wantMin: 0, wantMax: 0},
+		{name: "invalid header", value: "not-a-duration", wantMin: 0, wantMax: 0},
+		{name: "numeric seconds", value: "120", wantMin: 120 * time.Second, wantMax: 120 * time.Second},
+		{name: "HTTP-date in the future", value: future.Format(http.TimeFormat), wantMin: 110 * time.Second, wantMax: 130 * time.Second},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := parseRetryAfter(tc.value)
+			if got < tc.wantMin || got > tc.wantMax {
+				t.Errorf("parseRetryAfter(%q) = %s, want between %s and %s", tc.value, got, tc.wantMin, tc.wantMax)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_RateLimitRetriesAndSucceeds(t *testing.T) {
+	attempts := 0
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts == 1 {
+			resp := &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader("")),
+			}
+			resp.Header.Set("Retry-After", "0")
+			return resp, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+	})
+
+	transport := NewRetryTransport(rt, &RetryTransportConfig{
+		MaxAttempts: 2,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    10 * time.Millisecond,
+	})
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.invalid", nil)
+	if err != nil {
+		t.Fatalf("build request: %v", err)
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if attempts != 2 {
+		t.Fatalf("got %d attempts, want 2", attempts)
+	}
+}
+
+func TestRetryTransport_NetworkErrorExhaustsRetries(t *testing.T) {
+	cause := errors.New("connection refused")
+	attempts := 0
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return nil, cause
+	})
+
+	transport := NewRetryTransport(rt, &RetryTransportConfig{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    2 * time.Millisecond,
+	})
+
+	req, err := http.NewRequest(http.MethodGet, "http://example.invalid", nil)
+	if err != nil {
+		t.Fatalf("build request: %v", err)
+	}
+
+	_, err = transport.RoundTrip(req)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if attempts != 3 {
+		t.Fatalf("got %d attempts, want 3", attempts)
+	}
+
+	var exhausted *RetriesExhaustedError
+	if !errors.As(err, &exhausted) {
+		t.Fatalf("got error %v (%T), want *RetriesExhaustedError", err, err)
+	}
+	if !IsNetworkError(exhausted.Cause) {
+		t.Fatalf("exhausted.Cause = %v (%T), want a NetworkError wrapping %v", exhausted.Cause, exhausted.Cause, cause)
+	}
+}
+
+func TestRetryTransport_ContextCanceledMidBackoff(t *testing.T) {
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	})
+
+	// BaseDelay is well past ctx's timeout, so the first backoff sleep
+	// should observe ctx.Done() before a second attempt is ever made.
+	transport := NewRetryTransport(rt, &RetryTransportConfig{
+		MaxAttempts: 5,
+		BaseDelay:   1 * time.Second,
+		MaxDelay:    1 * time.Second,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.invalid", nil)
+	if err != nil {
+		t.Fatalf("build request: %v", err)
+	}
+
+	start := time.Now()
+	_, err = transport.RoundTrip(req)
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed > 500*time.Millisecond {
+		t.Fatalf("RoundTrip took %s, expected it to return promptly on context cancellation", elapsed)
+	}
+}
@@ -2,18 +2,89 @@ package janua
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"time"
 )
 
 // SessionsService handles session operations
 type SessionsService struct {
 	client *Client
+	// resilient carries its own retry policy and circuit breaker for
+	// revocation calls, which should stay available even while the
+	// ordinary request path is degraded. See Client.requestVia.
+	resilient *RetryableClient
 }
 
-// List returns all sessions for the current user
-func (s *SessionsService) List(ctx context.Context) (*Paginated[Session], error) {
-	resp, err := s.client.request(ctx, http.MethodGet, "/api/v1/sessions", nil)
+// SessionStore is the session management contract SessionsService
+// implements. It exists so callers (and tests) can substitute a mock or an
+// in-process store without depending on the HTTP-backed implementation.
+type SessionStore interface {
+	// List returns the active sessions belonging to userID.
+	List(ctx context.Context, userID string) (*Paginated[Session], error)
+	// Get returns a specific session by ID.
+	Get(ctx context.Context, sessionID string) (*Session, error)
+	// Revoke invalidates a specific session.
+	Revoke(ctx context.Context, sessionID string) error
+	// RevokeAllForUser invalidates every session belonging to userID, for
+	// example in response to a detected compromise.
+	RevokeAllForUser(ctx context.Context, userID string) error
+	// Touch updates a session's LastActivity to now, extending its idle
+	// timeout.
+	Touch(ctx context.Context, sessionID string) error
+}
+
+var _ SessionStore = (*SessionsService)(nil)
+
+// AuditLog represents an audit trail entry recorded by the Janua API.
+type AuditLog struct {
+	ID        string                 `json:"id"`
+	Action    string                 `json:"action"`
+	ActorID   string                 `json:"actor_id,omitempty"`
+	TargetID  string                 `json:"target_id,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// AuditActionRefreshTokenReuseDetected is the AuditLog.Action recorded when
+// a rotated refresh token is presented a second time, which revokes the
+// entire RefreshTokenFamily it belongs to. See TokenReuseError.
+const AuditActionRefreshTokenReuseDetected = "refresh_token_reuse_detected"
+
+// SessionEventType identifies the kind of change delivered by
+// SessionsService.Watch.
+type SessionEventType string
+
+const (
+	SessionEventCreated   SessionEventType = "created"
+	SessionEventRevoked   SessionEventType = "revoked"
+	SessionEventRefreshed SessionEventType = "refreshed"
+	// SessionEventStreamError marks the terminal event Watch sends when
+	// reconnection attempts are exhausted; Err holds the *StreamError.
+	SessionEventStreamError SessionEventType = "stream_error"
+)
+
+// SessionEvent is one change delivered by SessionsService.Watch.
+type SessionEvent struct {
+	Type    SessionEventType
+	Session Session
+	At      time.Time
+	// Err holds the *StreamError describing why the stream ended. It is
+	// only set when Type is SessionEventStreamError.
+	Err error
+}
+
+// List returns all active sessions for userID. Pass an empty userID to list
+// sessions for the caller's own token.
+func (s *SessionsService) List(ctx context.Context, userID string) (*Paginated[Session], error) {
+	path := "/api/v1/sessions"
+	if userID != "" {
+		path += "?user_id=" + url.QueryEscape(userID)
+	}
+
+	resp, err := s.client.request(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -40,10 +111,12 @@ func (s *SessionsService) Get(ctx context.Context, sessionID string) (*Session,
 	return &session, nil
 }
 
-// Revoke revokes a specific session
+// Revoke revokes a specific session. The request goes through
+// SessionsService's own retry policy and circuit breaker so revocation
+// stays available during partial outages.
 func (s *SessionsService) Revoke(ctx context.Context, sessionID string) error {
 	path := fmt.Sprintf("/api/v1/sessions/%s", sessionID)
-	resp, err := s.client.request(ctx, http.MethodDelete, path, nil)
+	resp, err := s.client.requestVia(ctx, http.MethodDelete, path, nil, s.resilient)
 	if err != nil {
 		return err
 	}
@@ -52,9 +125,62 @@ func (s *SessionsService) Revoke(ctx context.Context, sessionID string) error {
 
 // RevokeAll revokes all sessions for the current user
 func (s *SessionsService) RevokeAll(ctx context.Context) error {
-	resp, err := s.client.request(ctx, http.MethodDelete, "/api/v1/sessions", nil)
+	resp, err := s.client.requestVia(ctx, http.MethodDelete, "/api/v1/sessions", nil, s.resilient)
 	if err != nil {
 		return err
 	}
 	return decodeResponse(resp, nil)
 }
+
+// RevokeAllForUser revokes every session belonging to userID. Unlike
+// RevokeAll, which only affects the caller's own sessions, this targets an
+// arbitrary user and is intended for admin use and incident response (for
+// example, after a TokenReuseError). It goes through the same retry policy
+// and circuit breaker as Revoke.
+func (s *SessionsService) RevokeAllForUser(ctx context.Context, userID string) error {
+	path := fmt.Sprintf("/api/v1/users/%s/sessions", userID)
+	resp, err := s.client.requestVia(ctx, http.MethodDelete, path, nil, s.resilient)
+	if err != nil {
+		return err
+	}
+	return decodeResponse(resp, nil)
+}
+
+// Touch updates a session's LastActivity to now, extending its idle
+// timeout without otherwise changing it.
+func (s *SessionsService) Touch(ctx context.Context, sessionID string) error {
+	path := fmt.Sprintf("/api/v1/sessions/%s/touch", sessionID)
+	resp, err := s.client.request(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return err
+	}
+	return decodeResponse(resp, nil)
+}
+
+// Watch opens a real-time stream of session changes (created, revoked,
+// refreshed) for the current user, delivering events on the returned
+// channel until ctx is cancelled. The underlying connection is
+// re-established automatically on disconnect with full-jitter backoff,
+// resuming from the last delivered event so no events are lost across
+// reconnects. If reconnection attempts are exhausted, a final SessionEvent
+// with Type SessionEventStreamError and Err set is sent before the channel
+// is closed.
+func (s *SessionsService) Watch(ctx context.Context) (<-chan SessionEvent, error) {
+	ch := watchEvents(ctx, s.client, "/api/v1/sessions/watch",
+		func(ev sseEvent) (SessionEvent, bool) {
+			var payload struct {
+				Type    SessionEventType `json:"type"`
+				Session Session          `json:"session"`
+				At      time.Time        `json:"at"`
+			}
+			if err := json.Unmarshal([]byte(ev.data), &payload); err != nil {
+				return SessionEvent{}, false
+			}
+			return SessionEvent{Type: payload.Type, Session: payload.Session, At: payload.At}, true
+		},
+		func(streamErr *StreamError) SessionEvent {
+			return SessionEvent{Type: SessionEventStreamError, At: time.Now(), Err: streamErr}
+		},
+	)
+	return ch, nil
+}
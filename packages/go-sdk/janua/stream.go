@@ -0,0 +1,176 @@
+// Package janua provides real-time event streaming (SSE / long-poll) for
+// the Janua SDK
+package janua
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// streamMaxReconnectAttempts bounds how many times a Watch stream will
+	// re-establish its connection before giving up and sending a terminal
+	// StreamError.
+	streamMaxReconnectAttempts = 10
+	// streamBaseDelay and streamMaxDelay parameterize the same full-jitter
+	// backoff policy RetryTransport uses for HTTP retries.
+	streamBaseDelay = 500 * time.Millisecond
+	streamMaxDelay  = 30 * time.Second
+)
+
+// sseEvent is one decoded Server-Sent Events frame per the WHATWG
+// EventSource spec.
+type sseEvent struct {
+	id   string
+	name string
+	data string
+}
+
+// scanSSE scans body for SSE frames, invoking handle once per dispatched
+// event (a block of id:/event:/data: lines terminated by a blank line).
+// Lines starting with ":" are comments (commonly used as keep-alive pings)
+// and are ignored.
+func scanSSE(body io.Reader, handle func(sseEvent)) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 4096), 1<<20)
+
+	var ev sseEvent
+	var data strings.Builder
+	flush := func() {
+		if data.Len() == 0 {
+			return
+		}
+		ev.data = strings.TrimSuffix(data.String(), "\n")
+		handle(ev)
+		ev = sseEvent{}
+		data.Reset()
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "id:"):
+			ev.id = strings.TrimSpace(strings.TrimPrefix(line, "id:"))
+		case strings.HasPrefix(line, "event:"):
+			ev.name = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data.WriteString(strings.TrimPrefix(line, "data:"))
+			data.WriteString("\n")
+		case strings.HasPrefix(line, ":"):
+			// comment / keep-alive ping
+		}
+	}
+	flush()
+
+	return scanner.Err()
+}
+
+// streamRequest opens path as a streaming GET request (SSE or long-poll),
+// resuming from lastEventID via the Last-Event-ID header when non-empty.
+// The caller must close the returned response's Body.
+func (c *Client) streamRequest(ctx context.Context, path, lastEventID string) (*http.Response, error) {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	req.Header.Set("User-Agent", fmt.Sprintf("janua-go/%s", Version))
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	c.setAuthHeaders(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, NewNetworkError(err)
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, ParseAPIError(resp, body)
+	}
+
+	return resp, nil
+}
+
+// watchEvents opens path as an SSE stream and decodes each frame into a T
+// via decode, delivering results on the returned channel until ctx is
+// cancelled. On disconnect it reconnects with full-jitter exponential
+// backoff (the same policy as RetryTransport), resuming from the last
+// delivered event's id via Last-Event-ID so no events are lost across
+// reconnects. If reconnection attempts are exhausted, terminal is called to
+// build one final value describing the failure, which is sent before the
+// channel is closed.
+func watchEvents[T any](ctx context.Context, c *Client, path string, decode func(sseEvent) (T, bool), terminal func(*StreamError) T) <-chan T {
+	ch := make(chan T)
+
+	go func() {
+		defer close(ch)
+
+		lastEventID := ""
+		attempt := 0
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+
+			resp, err := c.streamRequest(ctx, path, lastEventID)
+			if err == nil {
+				attempt = 0
+				err = scanSSE(resp.Body, func(ev sseEvent) {
+					if ev.id != "" {
+						lastEventID = ev.id
+					}
+					if v, ok := decode(ev); ok {
+						select {
+						case ch <- v:
+						case <-ctx.Done():
+						}
+					}
+				})
+				resp.Body.Close()
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil {
+				// The server closed the stream cleanly; reconnect right away.
+				continue
+			}
+
+			attempt++
+			if attempt > streamMaxReconnectAttempts {
+				select {
+				case ch <- terminal(NewStreamError("reconnection attempts exhausted", attempt-1, err)):
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(fullJitterBackoff(attempt, streamBaseDelay, streamMaxDelay)):
+			}
+		}
+	}()
+
+	return ch
+}
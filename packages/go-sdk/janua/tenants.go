@@ -0,0 +1,108 @@
+package janua
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TenantsService handles tenant management operations
+type TenantsService struct {
+	client *Client
+}
+
+// Tenant represents a Janua tenant
+type Tenant struct {
+	ID        string                 `json:"id"`
+	Name      string                 `json:"name"`
+	Slug      string                 `json:"slug"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+// CreateTenantRequest represents a tenant creation request
+type CreateTenantRequest struct {
+	Name     string                 `json:"name"`
+	Slug     string                 `json:"slug,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// CreateTenant creates a new tenant
+func (s *TenantsService) CreateTenant(ctx context.Context, req *CreateTenantRequest) (*Tenant, error) {
+	resp, err := s.client.request(ctx, http.MethodPost, "/api/v1/tenants", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var tenant Tenant
+	if err := decodeResponse(resp, &tenant); err != nil {
+		return nil, err
+	}
+
+	return &tenant, nil
+}
+
+// GetTenant gets a tenant by ID
+func (s *TenantsService) GetTenant(ctx context.Context, tenantID string) (*Tenant, error) {
+	resp, err := s.client.request(ctx, http.MethodGet, fmt.Sprintf("/api/v1/tenants/%s", tenantID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tenant Tenant
+	if err := decodeResponse(resp, &tenant); err != nil {
+		return nil, err
+	}
+
+	return &tenant, nil
+}
+
+// ListTenants lists all tenants
+func (s *TenantsService) ListTenants(ctx context.Context, opts *ListOptions) (*Paginated[Tenant], error) {
+	path := appendQuery("/api/v1/tenants", opts.values())
+
+	resp, err := s.client.request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result Paginated[Tenant]
+	if err := decodeResponse(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateTenantRequest represents a tenant update request
+type UpdateTenantRequest struct {
+	Name     string                 `json:"name,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// UpdateTenant updates a tenant
+func (s *TenantsService) UpdateTenant(ctx context.Context, tenantID string, req *UpdateTenantRequest) (*Tenant, error) {
+	resp, err := s.client.request(ctx, http.MethodPut, fmt.Sprintf("/api/v1/tenants/%s", tenantID), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var tenant Tenant
+	if err := decodeResponse(resp, &tenant); err != nil {
+		return nil, err
+	}
+
+	return &tenant, nil
+}
+
+// DeleteTenant deletes a tenant
+func (s *TenantsService) DeleteTenant(ctx context.Context, tenantID string) error {
+	resp, err := s.client.request(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/tenants/%s", tenantID), nil)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(resp, nil)
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 )
 
 // UsersService handles user management operations
@@ -11,6 +14,80 @@ type UsersService struct {
 	client *Client
 }
 
+// UserSortField is the set of fields ListUsers can sort by.
+type UserSortField string
+
+const (
+	UserSortCreatedAt UserSortField = "created_at"
+	UserSortUpdatedAt UserSortField = "updated_at"
+	UserSortEmail     UserSortField = "email"
+)
+
+// UserListOptions filters and paginates UsersService.ListUsers. It
+// supersedes passing a bare ListOptions where callers need to filter by
+// status, org/role membership, or creation window rather than just
+// search/sort.
+type UserListOptions struct {
+	Page    int
+	PerPage int
+	Cursor  string
+	Search  string
+	Order   string
+	Sort    UserSortField
+
+	Status        string
+	Email         string
+	OrgID         string
+	RoleID        string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+// values encodes o as URL query parameters, properly escaping each value.
+func (o *UserListOptions) values() url.Values {
+	v := url.Values{}
+	if o == nil {
+		return v
+	}
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	if o.Cursor != "" {
+		v.Set("cursor", o.Cursor)
+	}
+	if o.Search != "" {
+		v.Set("search", o.Search)
+	}
+	if o.Order != "" {
+		v.Set("order", o.Order)
+	}
+	if o.Sort != "" {
+		v.Set("sort", string(o.Sort))
+	}
+	if o.Status != "" {
+		v.Set("status", o.Status)
+	}
+	if o.Email != "" {
+		v.Set("email", o.Email)
+	}
+	if o.OrgID != "" {
+		v.Set("org_id", o.OrgID)
+	}
+	if o.RoleID != "" {
+		v.Set("role_id", o.RoleID)
+	}
+	if !o.CreatedAfter.IsZero() {
+		v.Set("created_after", o.CreatedAfter.Format(time.RFC3339))
+	}
+	if !o.CreatedBefore.IsZero() {
+		v.Set("created_before", o.CreatedBefore.Format(time.RFC3339))
+	}
+	return v
+}
+
 // GetCurrentUser gets the current authenticated user
 func (s *UsersService) GetCurrentUser(ctx context.Context) (*User, error) {
 	resp, err := s.client.request(ctx, http.MethodGet, "/api/v1/users/me", nil)
@@ -41,20 +118,9 @@ func (s *UsersService) GetUser(ctx context.Context, userID string) (*User, error
 	return &user, nil
 }
 
-// ListUsers lists all users
-func (s *UsersService) ListUsers(ctx context.Context, opts *ListOptions) (*Paginated[User], error) {
-	// Build query parameters
-	path := "/api/v1/users"
-	if opts != nil {
-		// Add query parameters
-		path = fmt.Sprintf("%s?page=%d&per_page=%d", path, opts.Page, opts.PerPage)
-		if opts.Search != "" {
-			path = fmt.Sprintf("%s&search=%s", path, opts.Search)
-		}
-		if opts.Sort != "" {
-			path = fmt.Sprintf("%s&sort=%s&order=%s", path, opts.Sort, opts.Order)
-		}
-	}
+// ListUsers lists all users, filtered and sorted by opts.
+func (s *UsersService) ListUsers(ctx context.Context, opts *UserListOptions) (*Paginated[User], error) {
+	path := appendQuery("/api/v1/users", opts.values())
 
 	resp, err := s.client.request(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -69,6 +135,21 @@ func (s *UsersService) ListUsers(ctx context.Context, opts *ListOptions) (*Pagin
 	return &result, nil
 }
 
+// ListAllUsers returns an Iterator over every user matching opts, walking
+// all pages as it's consumed so callers don't have to loop over ListUsers
+// themselves.
+func (s *UsersService) ListAllUsers(opts *UserListOptions) *Iterator[User] {
+	base := UserListOptions{}
+	if opts != nil {
+		base = *opts
+	}
+	return newIterator(func(ctx context.Context, page int) (*Paginated[User], error) {
+		pageOpts := base
+		pageOpts.Page = page
+		return s.ListUsers(ctx, &pageOpts)
+	})
+}
+
 // UpdateUserRequest represents a user update request
 type UpdateUserRequest struct {
 	FirstName string                 `json:"first_name,omitempty"`
@@ -235,9 +316,9 @@ func (s *UsersService) RevokeAllUserSessions(ctx context.Context, userID string)
 }
 
 // SearchUsers searches for users
-func (s *UsersService) SearchUsers(ctx context.Context, query string, opts *ListOptions) (*Paginated[User], error) {
+func (s *UsersService) SearchUsers(ctx context.Context, query string, opts *UserListOptions) (*Paginated[User], error) {
 	if opts == nil {
-		opts = &ListOptions{}
+		opts = &UserListOptions{}
 	}
 	opts.Search = query
 
@@ -280,3 +361,64 @@ func (s *UsersService) BulkInviteUsers(ctx context.Context, req *BulkInviteReque
 
 	return users, nil
 }
+
+// ResourceRef identifies the resource a permission check applies to (e.g.
+// Type "organization", ID the org's ID). A zero ResourceRef checks a
+// global, non-resource-scoped permission.
+type ResourceRef struct {
+	Type string `json:"type,omitempty"`
+	ID   string `json:"id,omitempty"`
+}
+
+// PermissionQuery is one check in a CheckPermissions batch.
+type PermissionQuery struct {
+	Permission string      `json:"permission"`
+	Resource   ResourceRef `json:"resource,omitempty"`
+}
+
+// Decision is the API's answer to a permission check, including why a
+// check was denied (e.g. which role grant, or lack thereof, decided it).
+type Decision struct {
+	Permission string      `json:"permission"`
+	Resource   ResourceRef `json:"resource,omitempty"`
+	Allowed    bool        `json:"allowed"`
+	Reason     string      `json:"reason,omitempty"`
+}
+
+// CheckPermission reports whether userID holds permission against
+// resource.
+func (s *UsersService) CheckPermission(ctx context.Context, userID, permission string, resource ResourceRef) (bool, *Decision, error) {
+	req := PermissionQuery{Permission: permission, Resource: resource}
+
+	resp, err := s.client.request(ctx, http.MethodPost, fmt.Sprintf("/api/v1/users/%s/permissions/check", userID), req)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var decision Decision
+	if err := decodeResponse(resp, &decision); err != nil {
+		return false, nil, err
+	}
+
+	return decision.Allowed, &decision, nil
+}
+
+// CheckPermissions evaluates queries against userID in a single round
+// trip, returning one Decision per query in the same order.
+func (s *UsersService) CheckPermissions(ctx context.Context, userID string, queries []PermissionQuery) ([]Decision, error) {
+	req := struct {
+		Queries []PermissionQuery `json:"queries"`
+	}{Queries: queries}
+
+	resp, err := s.client.request(ctx, http.MethodPost, fmt.Sprintf("/api/v1/users/%s/permissions/check-batch", userID), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var decisions []Decision
+	if err := decodeResponse(resp, &decisions); err != nil {
+		return nil, err
+	}
+
+	return decisions, nil
+}
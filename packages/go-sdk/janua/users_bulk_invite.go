@@ -0,0 +1,227 @@
+package janua
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// BulkInviteRow is one row of a BulkInviteUsersStream CSV input: email,
+// role, org_id, and an optional metadata column holding a JSON object.
+type BulkInviteRow struct {
+	Email    string
+	RoleID   string
+	OrgID    string
+	Metadata map[string]interface{}
+}
+
+// BulkInviteResult is the per-row outcome of a BulkInviteUsersStream
+// batch, so a caller can build a report of what succeeded or failed and
+// why instead of an all-or-nothing error.
+type BulkInviteResult struct {
+	Email      string
+	User       *User
+	Error      error
+	HTTPStatus int
+}
+
+// BulkInviteStreamOptions configures BulkInviteUsersStream.
+type BulkInviteStreamOptions struct {
+	// BatchSize caps how many rows are sent per underlying request.
+	// Defaults to 50.
+	BatchSize int
+	// Retry configures retries for a transient (429/5xx) batch failure.
+	// Defaults to DefaultRetryConfig().
+	Retry *RetryConfig
+	// Progress, if set, is called after each batch completes with the
+	// number of rows processed so far and the total row count.
+	Progress func(done, total int)
+}
+
+// BulkInviteUsersStream reads CSV rows (columns: email, role, org_id,
+// metadata - the last holding a JSON object, or empty) from r, sends them
+// to the bulk invite endpoint in batches of opts.BatchSize, retrying a
+// transient (429/5xx) batch failure with exponential backoff, and returns
+// one BulkInviteResult per row, in input order. Unlike BulkInviteUsers,
+// it never returns a single all-or-nothing error for row-level failures:
+// a row that the API rejects, or a batch that exhausts its retries, is
+// recorded on that row's result instead of aborting the rest of the
+// import.
+func (s *UsersService) BulkInviteUsersStream(ctx context.Context, r io.Reader, opts *BulkInviteStreamOptions) ([]BulkInviteResult, error) {
+	rows, err := parseBulkInviteCSV(r)
+	if err != nil {
+		return nil, fmt.Errorf("parse bulk invite CSV: %w", err)
+	}
+
+	batchSize := 50
+	retryConfig := DefaultRetryConfig()
+	var progress func(done, total int)
+	if opts != nil {
+		if opts.BatchSize > 0 {
+			batchSize = opts.BatchSize
+		}
+		if opts.Retry != nil {
+			retryConfig = opts.Retry
+		}
+		progress = opts.Progress
+	}
+	retryer := NewRetryer(retryConfig)
+
+	results := make([]BulkInviteResult, 0, len(rows))
+	for start := 0; start < len(rows); start += batchSize {
+		end := start + batchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		batch := rows[start:end]
+
+		batchResults, err := DoWithResult(ctx, retryer, func() ([]BulkInviteResult, error) {
+			return s.sendBulkInviteBatch(ctx, batch)
+		})
+		if err != nil {
+			// The batch never got a usable response even after retries
+			// (e.g. a network error, or a non-retryable failure) - record
+			// it against every row in the batch rather than losing them.
+			batchResults = make([]BulkInviteResult, len(batch))
+			for i, row := range batch {
+				batchResults[i] = BulkInviteResult{Email: row.Email, Error: err}
+			}
+		}
+		results = append(results, batchResults...)
+
+		if progress != nil {
+			progress(end, len(rows))
+		}
+	}
+
+	return results, nil
+}
+
+// sendBulkInviteBatch sends one batch of rows and returns one
+// BulkInviteResult per row.
+func (s *UsersService) sendBulkInviteBatch(ctx context.Context, batch []BulkInviteRow) ([]BulkInviteResult, error) {
+	req := struct {
+		Invites []BulkInviteRow `json:"invites"`
+	}{Invites: batch}
+
+	resp, err := s.client.request(ctx, http.MethodPost, "/api/v1/users/bulk-invite", req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parsed through the richer JanuaError taxonomy (rather than
+	// decodeResponse's plain APIError) so the retryer can recognize a
+	// retryable status code via IsRetryable.
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, ParseAPIError(resp, body)
+	}
+
+	var decoded struct {
+		Results []BulkInviteResult `json:"results"`
+	}
+	if err := decodeResponse(resp, &decoded); err != nil {
+		return nil, err
+	}
+
+	return decoded.Results, nil
+}
+
+// MarshalJSON encodes a BulkInviteRow's Error as a string for the wire,
+// since error isn't itself marshalable.
+func (row BulkInviteRow) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Email    string                 `json:"email"`
+		RoleID   string                 `json:"role_id,omitempty"`
+		OrgID    string                 `json:"org_id,omitempty"`
+		Metadata map[string]interface{} `json:"metadata,omitempty"`
+	}{
+		Email:    row.Email,
+		RoleID:   row.RoleID,
+		OrgID:    row.OrgID,
+		Metadata: row.Metadata,
+	})
+}
+
+// UnmarshalJSON decodes a BulkInviteResult from the wire, where Error is a
+// plain string rather than a Go error.
+func (res *BulkInviteResult) UnmarshalJSON(data []byte) error {
+	var wire struct {
+		Email      string `json:"email"`
+		User       *User  `json:"user,omitempty"`
+		Error      string `json:"error,omitempty"`
+		HTTPStatus int    `json:"http_status,omitempty"`
+	}
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	res.Email = wire.Email
+	res.User = wire.User
+	res.HTTPStatus = wire.HTTPStatus
+	if wire.Error != "" {
+		res.Error = fmt.Errorf("%s", wire.Error)
+	}
+	return nil
+}
+
+// parseBulkInviteCSV reads header-led CSV into BulkInviteRows. Columns may
+// appear in any order; only "email" is required.
+func parseBulkInviteCSV(r io.Reader) ([]BulkInviteRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	col := make(map[string]int, len(header))
+	for i, name := range header {
+		col[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := col["email"]; !ok {
+		return nil, fmt.Errorf("missing required %q column", "email")
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := col[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []BulkInviteRow
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row := BulkInviteRow{
+			Email:  field(record, "email"),
+			RoleID: field(record, "role"),
+			OrgID:  field(record, "org_id"),
+		}
+		if raw := field(record, "metadata"); raw != "" {
+			if err := json.Unmarshal([]byte(raw), &row.Metadata); err != nil {
+				return nil, fmt.Errorf("row %d: parse metadata: %w", len(rows)+2, err)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
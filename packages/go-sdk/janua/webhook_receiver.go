@@ -0,0 +1,291 @@
+package janua
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Event type constants for WebhookEvent.Type
+const (
+	EventUserCreated    = "user.created"
+	EventUserDeleted    = "user.deleted"
+	EventSessionRevoked = "session.revoked"
+	EventMFAEnrolled    = "mfa.enrolled"
+)
+
+// UserCreatedEvent is the typed payload of a "user.created" webhook event
+type UserCreatedEvent struct {
+	User User `json:"user"`
+}
+
+// SessionRevokedEvent is the typed payload of a "session.revoked" webhook event
+type SessionRevokedEvent struct {
+	SessionID string `json:"session_id"`
+	UserID    string `json:"user_id"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// MFAEnrolledEvent is the typed payload of a "mfa.enrolled" webhook event
+type MFAEnrolledEvent struct {
+	UserID string `json:"user_id"`
+	Method string `json:"method"`
+}
+
+// decodeEventData unmarshals the event's Data map into a typed struct
+func decodeEventData(data map[string]interface{}, v interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// EventHandler processes a single webhook event
+type EventHandler func(ctx context.Context, event *WebhookEvent) error
+
+// WebhookReceiverConfig configures a WebhookReceiver
+type WebhookReceiverConfig struct {
+	// Secrets is the set of active signing secrets. Multiple secrets allow
+	// zero-downtime rotation: a sender can be switched to a new secret while
+	// the receiver still accepts signatures from the old one until it is
+	// retired.
+	Secrets []string
+	// ClockSkew bounds how far the X-Janua-Signature header's timestamp may
+	// drift from the receiver's clock before a request is rejected as a
+	// possible replay. Defaults to 5 minutes.
+	ClockSkew time.Duration
+}
+
+// WebhookReceiver verifies and dispatches incoming Janua webhook deliveries.
+// It implements http.Handler so it can be mounted directly on a ServeMux.
+type WebhookReceiver struct {
+	secrets   []string
+	clockSkew time.Duration
+
+	mu       sync.RWMutex
+	handlers map[string][]EventHandler
+}
+
+// NewWebhookReceiver creates a WebhookReceiver from the given config
+func NewWebhookReceiver(config *WebhookReceiverConfig) *WebhookReceiver {
+	if config == nil {
+		config = &WebhookReceiverConfig{}
+	}
+	clockSkew := config.ClockSkew
+	if clockSkew == 0 {
+		clockSkew = 5 * time.Minute
+	}
+	return &WebhookReceiver{
+		secrets:   config.Secrets,
+		clockSkew: clockSkew,
+		handlers:  make(map[string][]EventHandler),
+	}
+}
+
+// On registers a handler to be called for events of the given type
+// (e.g. "user.created"). Multiple handlers may be registered for the
+// same type; they are called in registration order.
+func (r *WebhookReceiver) On(eventType string, handler EventHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[eventType] = append(r.handlers[eventType], handler)
+}
+
+// ServeHTTP validates the request signature, decodes the event, and
+// dispatches it to any handlers registered for its type.
+func (r *WebhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	defer req.Body.Close()
+
+	signatureHeader := req.Header.Get("X-Janua-Signature")
+
+	if err := r.verify(body, signatureHeader); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, "invalid event payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := r.dispatch(req.Context(), &event); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatch calls every handler registered for event.Type, returning the
+// first error encountered.
+func (r *WebhookReceiver) dispatch(ctx context.Context, event *WebhookEvent) error {
+	r.mu.RLock()
+	handlers := r.handlers[event.Type]
+	r.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			return fmt.Errorf("webhook handler for %q: %w", event.Type, err)
+		}
+	}
+	return nil
+}
+
+// verify checks the timestamp skew and HMAC signature of an inbound
+// delivery's combined "t=<unix-timestamp>,v1=<hex-hmac>" signature header
+// (the same format VerifyWebhook checks) against every active secret, so
+// a WebhookReceiver and a caller using VerifyWebhook directly accept
+// exactly the same deliveries.
+func (r *WebhookReceiver) verify(body []byte, signatureHeader string) error {
+	if signatureHeader == "" {
+		return fmt.Errorf("missing signature header")
+	}
+
+	timestamp, signature, err := parseWebhookHeader(signatureHeader)
+	if err != nil {
+		return err
+	}
+	if err := checkWebhookTimestamp(timestamp, r.clockSkew); err != nil {
+		return err
+	}
+
+	for _, secret := range r.secrets {
+		expected := hex.EncodeToString(webhookMAC(secret, timestamp, body))
+		if hmac.Equal([]byte(expected), []byte(signature)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("signature verification failed")
+}
+
+// AsUserCreated decodes the event's Data as a UserCreatedEvent
+func (e *WebhookEvent) AsUserCreated() (*UserCreatedEvent, error) {
+	var out UserCreatedEvent
+	if err := decodeEventData(e.Data, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AsSessionRevoked decodes the event's Data as a SessionRevokedEvent
+func (e *WebhookEvent) AsSessionRevoked() (*SessionRevokedEvent, error) {
+	var out SessionRevokedEvent
+	if err := decodeEventData(e.Data, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AsMFAEnrolled decodes the event's Data as an MFAEnrolledEvent
+func (e *WebhookEvent) AsMFAEnrolled() (*MFAEnrolledEvent, error) {
+	var out MFAEnrolledEvent
+	if err := decodeEventData(e.Data, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// VerifyWebhookOptions configures VerifyWebhook.
+type VerifyWebhookOptions struct {
+	// MaxClockSkew bounds how far the header's timestamp may drift from the
+	// local clock before the delivery is rejected as a possible replay.
+	// Defaults to 5 minutes.
+	MaxClockSkew time.Duration
+}
+
+// VerifyWebhook checks a combined "t=<unix-timestamp>,v1=<hex-hmac>"
+// signature header - the one wire format every Janua webhook delivery
+// uses, whether read through WebhookReceiver or verified directly here -
+// against body using secret. It reports an error describing why
+// verification failed, so callers can distinguish a malformed header from
+// an expired timestamp or a bad signature.
+func VerifyWebhook(secret, header string, body []byte, opts *VerifyWebhookOptions) error {
+	if opts == nil {
+		opts = &VerifyWebhookOptions{}
+	}
+	maxSkew := opts.MaxClockSkew
+	if maxSkew == 0 {
+		maxSkew = 5 * time.Minute
+	}
+
+	timestamp, signature, err := parseWebhookHeader(header)
+	if err != nil {
+		return err
+	}
+
+	if err := checkWebhookTimestamp(timestamp, maxSkew); err != nil {
+		return err
+	}
+
+	expected := hex.EncodeToString(webhookMAC(secret, timestamp, body))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return fmt.Errorf("signature verification failed")
+	}
+	return nil
+}
+
+// parseWebhookHeader splits a "t=<timestamp>,v1=<signature>" header into
+// its two components.
+func parseWebhookHeader(header string) (timestamp, signature string, err error) {
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			timestamp = kv[1]
+		case "v1":
+			signature = kv[1]
+		}
+	}
+	if timestamp == "" || signature == "" {
+		return "", "", fmt.Errorf("malformed signature header %q", header)
+	}
+	return timestamp, signature, nil
+}
+
+// checkWebhookTimestamp reports an error if timestamp (a decimal Unix
+// time, as found in a signature header) is further than maxSkew from the
+// current time in either direction.
+func checkWebhookTimestamp(timestamp string, maxSkew time.Duration) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp in signature header: %w", err)
+	}
+
+	skew := time.Since(time.Unix(ts, 0))
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > maxSkew {
+		return fmt.Errorf("timestamp outside of allowed clock skew")
+	}
+	return nil
+}
+
+// webhookMAC computes the HMAC-SHA256 of "timestamp.body" using secret,
+// the signed content behind every "t=...,v1=..." signature header.
+func webhookMAC(secret, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -2,8 +2,10 @@ package janua
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // WebhooksService handles webhook operations
@@ -11,6 +13,33 @@ type WebhooksService struct {
 	client *Client
 }
 
+// WebhookDeliveryEventType identifies the kind of delivery update delivered
+// by WebhooksService.Watch.
+type WebhookDeliveryEventType string
+
+const (
+	WebhookDeliverySucceeded      WebhookDeliveryEventType = "succeeded"
+	WebhookDeliveryFailed         WebhookDeliveryEventType = "failed"
+	WebhookDeliveryRetryScheduled WebhookDeliveryEventType = "retry_scheduled"
+	// WebhookDeliveryStreamError marks the terminal event Watch sends when
+	// reconnection attempts are exhausted; Err holds the *StreamError.
+	WebhookDeliveryStreamError WebhookDeliveryEventType = "stream_error"
+)
+
+// WebhookDeliveryEvent is one delivery attempt update delivered by
+// WebhooksService.Watch.
+type WebhookDeliveryEvent struct {
+	Type       WebhookDeliveryEventType
+	WebhookID  string
+	EventID    string
+	Attempt    int
+	StatusCode int
+	At         time.Time
+	// Err holds the *StreamError describing why the stream ended. It is
+	// only set when Type is WebhookDeliveryStreamError.
+	Err error
+}
+
 // Webhook represents a webhook configuration
 type Webhook struct {
 	ID        string   `json:"id"`
@@ -103,3 +132,52 @@ func (s *WebhooksService) Delete(ctx context.Context, webhookID string) error {
 	}
 	return decodeResponse(resp, nil)
 }
+
+// Watch opens a real-time stream of webhook delivery attempts (succeeded,
+// failed, retry-scheduled) across all webhooks, so dashboards can render
+// live delivery health without polling. Reconnection behaves as described
+// on SessionsService.Watch.
+func (s *WebhooksService) Watch(ctx context.Context) (<-chan WebhookDeliveryEvent, error) {
+	ch := watchEvents(ctx, s.client, "/api/v1/webhooks/watch",
+		func(ev sseEvent) (WebhookDeliveryEvent, bool) {
+			var payload struct {
+				Type       WebhookDeliveryEventType `json:"type"`
+				WebhookID  string                   `json:"webhook_id"`
+				EventID    string                   `json:"event_id"`
+				Attempt    int                      `json:"attempt"`
+				StatusCode int                      `json:"status_code"`
+				At         time.Time                `json:"at"`
+			}
+			if err := json.Unmarshal([]byte(ev.data), &payload); err != nil {
+				return WebhookDeliveryEvent{}, false
+			}
+			return WebhookDeliveryEvent{
+				Type:       payload.Type,
+				WebhookID:  payload.WebhookID,
+				EventID:    payload.EventID,
+				Attempt:    payload.Attempt,
+				StatusCode: payload.StatusCode,
+				At:         payload.At,
+			}, true
+		},
+		func(streamErr *StreamError) WebhookDeliveryEvent {
+			return WebhookDeliveryEvent{Type: WebhookDeliveryStreamError, At: time.Now(), Err: streamErr}
+		},
+	)
+	return ch, nil
+}
+
+// Handler returns an http.Handler that verifies incoming webhook deliveries
+// against secret and dispatches each event to the callback registered for
+// its Type (e.g. EventUserCreated, EventSessionRevoked) in handlers, so
+// callers don't need to hand-roll signature parsing or event routing. It's
+// a thin convenience wrapper around WebhookReceiver for the common
+// single-secret, fixed-handler-set case; use NewWebhookReceiver directly
+// for secret rotation or dynamic handler registration.
+func (s *WebhooksService) Handler(secret string, handlers map[string]EventHandler) http.Handler {
+	receiver := NewWebhookReceiver(&WebhookReceiverConfig{Secrets: []string{secret}})
+	for eventType, handler := range handlers {
+		receiver.On(eventType, handler)
+	}
+	return receiver
+}
@@ -0,0 +1,209 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ErrNotFound is returned by a Provider method when the requested resource
+// doesn't exist, so the handler can respond 404 instead of 500.
+var ErrNotFound = errors.New("scim: resource not found")
+
+// Provider is the contract a downstream service implements to be
+// provisioned by Janua over SCIM. NewHandler dispatches each SCIM
+// operation to the matching Provider method.
+type Provider interface {
+	CreateUser(ctx context.Context, user *User) (*User, error)
+	GetUser(ctx context.Context, id string) (*User, error)
+	ListUsers(ctx context.Context, params ListParams) (*ListResponse[User], error)
+	PatchUser(ctx context.Context, id string, ops []PatchOp) (*User, error)
+	ReplaceUser(ctx context.Context, id string, user *User) (*User, error)
+	DeleteUser(ctx context.Context, id string) error
+
+	CreateGroup(ctx context.Context, group *Group) (*Group, error)
+	GetGroup(ctx context.Context, id string) (*Group, error)
+	ListGroups(ctx context.Context, params ListParams) (*ListResponse[Group], error)
+	PatchGroup(ctx context.Context, id string, ops []PatchOp) (*Group, error)
+	ReplaceGroup(ctx context.Context, id string, group *Group) (*Group, error)
+	DeleteGroup(ctx context.Context, id string) error
+}
+
+// handler routes SCIM requests to a Provider.
+type handler struct {
+	provider Provider
+}
+
+// NewHandler returns an http.Handler implementing the SCIM 2.0 Users and
+// Groups endpoints (RFC 7644 §3) by dispatching to provider. Mount it at
+// the SCIM base path, e.g. `mux.Handle("/scim/v2/", http.StripPrefix("/scim/v2", scim.NewHandler(p)))`.
+func NewHandler(provider Provider) http.Handler {
+	return &handler{provider: provider}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/")
+	switch {
+	case path == "Users" || path == "Users/":
+		h.serveUsersCollection(w, req)
+	case strings.HasPrefix(path, "Users/"):
+		h.serveUserResource(w, req, strings.TrimPrefix(path, "Users/"))
+	case path == "Groups" || path == "Groups/":
+		h.serveGroupsCollection(w, req)
+	case strings.HasPrefix(path, "Groups/"):
+		h.serveGroupResource(w, req, strings.TrimPrefix(path, "Groups/"))
+	default:
+		writeError(w, http.StatusNotFound, "no such SCIM resource type")
+	}
+}
+
+func (h *handler) serveUsersCollection(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		result, err := h.provider.ListUsers(req.Context(), parseListParams(req))
+		writeResult(w, result, err)
+	case http.MethodPost:
+		var user User
+		if err := json.NewDecoder(req.Body).Decode(&user); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		result, err := h.provider.CreateUser(req.Context(), &user)
+		writeCreated(w, result, err)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (h *handler) serveUserResource(w http.ResponseWriter, req *http.Request, id string) {
+	switch req.Method {
+	case http.MethodGet:
+		result, err := h.provider.GetUser(req.Context(), id)
+		writeResult(w, result, err)
+	case http.MethodPut:
+		var user User
+		if err := json.NewDecoder(req.Body).Decode(&user); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		result, err := h.provider.ReplaceUser(req.Context(), id, &user)
+		writeResult(w, result, err)
+	case http.MethodPatch:
+		var patch PatchRequest
+		if err := json.NewDecoder(req.Body).Decode(&patch); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		result, err := h.provider.PatchUser(req.Context(), id, patch.Operations)
+		writeResult(w, result, err)
+	case http.MethodDelete:
+		err := h.provider.DeleteUser(req.Context(), id)
+		writeDeleted(w, err)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (h *handler) serveGroupsCollection(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		result, err := h.provider.ListGroups(req.Context(), parseListParams(req))
+		writeResult(w, result, err)
+	case http.MethodPost:
+		var group Group
+		if err := json.NewDecoder(req.Body).Decode(&group); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		result, err := h.provider.CreateGroup(req.Context(), &group)
+		writeCreated(w, result, err)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (h *handler) serveGroupResource(w http.ResponseWriter, req *http.Request, id string) {
+	switch req.Method {
+	case http.MethodGet:
+		result, err := h.provider.GetGroup(req.Context(), id)
+		writeResult(w, result, err)
+	case http.MethodPut:
+		var group Group
+		if err := json.NewDecoder(req.Body).Decode(&group); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		result, err := h.provider.ReplaceGroup(req.Context(), id, &group)
+		writeResult(w, result, err)
+	case http.MethodPatch:
+		var patch PatchRequest
+		if err := json.NewDecoder(req.Body).Decode(&patch); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		result, err := h.provider.PatchGroup(req.Context(), id, patch.Operations)
+		writeResult(w, result, err)
+	case http.MethodDelete:
+		err := h.provider.DeleteGroup(req.Context(), id)
+		writeDeleted(w, err)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func parseListParams(req *http.Request) ListParams {
+	q := req.URL.Query()
+	params := ListParams{Filter: q.Get("filter"), StartIndex: 1, Count: 100}
+	if v, err := strconv.Atoi(q.Get("startIndex")); err == nil && v > 0 {
+		params.StartIndex = v
+	}
+	if v, err := strconv.Atoi(q.Get("count")); err == nil && v > 0 {
+		params.Count = v
+	}
+	return params
+}
+
+func writeResult(w http.ResponseWriter, v interface{}, err error) {
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, v)
+}
+
+func writeCreated(w http.ResponseWriter, v interface{}, err error) {
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, v)
+}
+
+func writeDeleted(w http.ResponseWriter, err error) {
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeError(w http.ResponseWriter, status int, detail string) {
+	writeJSON(w, status, newError(status, detail))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,79 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PatchOp is one operation within a PATCH request body, per RFC 7644 §3.5.2.
+type PatchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// PatchRequest is the body of a PATCH /Users/{id} or PATCH /Groups/{id}
+// request.
+type PatchRequest struct {
+	Schemas    []string  `json:"schemas"`
+	Operations []PatchOp `json:"Operations"`
+}
+
+// PathExpr is a parsed PatchOp.Path: an attribute, optionally qualified by
+// a filter on a multi-valued attribute (e.g. `emails[type eq "work"].value`).
+type PathExpr struct {
+	Attribute string
+	Filter    string // e.g. `type eq "work"`; empty if Path had no filter
+	SubAttr   string // e.g. "value" in the example above; empty if none
+}
+
+// ParsePath parses a PatchOp.Path expression. An empty path (valid for "op":
+// "replace" operations that replace the whole resource) yields a zero
+// PathExpr.
+func ParsePath(path string) (PathExpr, error) {
+	if path == "" {
+		return PathExpr{}, nil
+	}
+
+	attr := path
+	var filter, subAttr string
+
+	if open := strings.Index(path, "["); open >= 0 {
+		shut := strings.Index(path, "]")
+		if shut < open {
+			return PathExpr{}, fmt.Errorf("malformed path expression %q: unbalanced brackets", path)
+		}
+		attr = path[:open]
+		filter = path[open+1 : shut]
+		if rest := path[shut+1:]; strings.HasPrefix(rest, ".") {
+			subAttr = rest[1:]
+		}
+	} else if dot := strings.Index(path, "."); dot >= 0 {
+		attr = path[:dot]
+		subAttr = path[dot+1:]
+	}
+
+	return PathExpr{Attribute: attr, Filter: filter, SubAttr: subAttr}, nil
+}
+
+// DecodeValue unmarshals a PatchOp's Value (already a json.RawMessage-like
+// interface{} from the decoded request body) into v.
+func (op PatchOp) DecodeValue(v interface{}) error {
+	raw, err := json.Marshal(op.Value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Validate reports whether op.Op is one of the three operations RFC 7644
+// defines for PATCH.
+func (op PatchOp) Validate() error {
+	switch strings.ToLower(op.Op) {
+	case "add", "remove", "replace":
+		return nil
+	default:
+		return fmt.Errorf("unsupported patch op %q", op.Op)
+	}
+}
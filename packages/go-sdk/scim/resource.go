@@ -0,0 +1,108 @@
+// Package scim lets a downstream service act as a SCIM 2.0 provisioning
+// target for Janua-managed identities: it provides the RFC 7643/7644
+// resource types and an http.Handler that dispatches create/read/update/
+// delete/list requests to a caller-supplied Provider.
+package scim
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	schemaUser     = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup    = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaListResp = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp  = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError    = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// Meta is the SCIM resource metadata block attached to every resource.
+type Meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created,omitempty"`
+	LastModified time.Time `json:"lastModified,omitempty"`
+	Location     string    `json:"location,omitempty"`
+}
+
+// Email is one entry of a User's "emails" multi-valued attribute.
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// Name is a User's "name" complex attribute.
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// User is the SCIM core User resource.
+type User struct {
+	Schemas    []string `json:"schemas"`
+	ID         string   `json:"id,omitempty"`
+	ExternalID string   `json:"externalId,omitempty"`
+	UserName   string   `json:"userName"`
+	Name       Name     `json:"name,omitempty"`
+	Emails     []Email  `json:"emails,omitempty"`
+	Active     bool     `json:"active"`
+	Meta       *Meta    `json:"meta,omitempty"`
+}
+
+// GroupMember is one entry of a Group's "members" multi-valued attribute.
+type GroupMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// Group is the SCIM core Group resource.
+type Group struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	ExternalID  string        `json:"externalId,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []GroupMember `json:"members,omitempty"`
+	Meta        *Meta         `json:"meta,omitempty"`
+}
+
+// ListResponse wraps a page of resources per RFC 7644 §3.4.2.
+type ListResponse[T any] struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []T      `json:"Resources"`
+}
+
+// NewListResponse builds a ListResponse for the given page of resources.
+func NewListResponse[T any](resources []T, totalResults, startIndex, itemsPerPage int) *ListResponse[T] {
+	return &ListResponse[T]{
+		Schemas:      []string{schemaListResp},
+		TotalResults: totalResults,
+		StartIndex:   startIndex,
+		ItemsPerPage: itemsPerPage,
+		Resources:    resources,
+	}
+}
+
+// ListParams are the query parameters ServeHTTP recognizes on a list
+// request (GET /Users, GET /Groups).
+type ListParams struct {
+	Filter     string
+	StartIndex int
+	Count      int
+}
+
+// Error is the SCIM error response body per RFC 7644 §3.12.
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	Detail   string   `json:"detail,omitempty"`
+	ScimType string   `json:"scimType,omitempty"`
+}
+
+func newError(status int, detail string) *Error {
+	return &Error{Schemas: []string{schemaError}, Status: strconv.Itoa(status), Detail: detail}
+}